@@ -0,0 +1,114 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// cacheVersion is part of every key, change it when processing changes
+	// so stale results are not reused
+	cacheVersion = "embed-cache-1"
+)
+
+// cache stores processed file contents on disk keyed by a hash of the input
+// and the options used to process it. A nil cache or one without a directory
+// never hits and discards stores, a read only cache discards stores.
+type cache struct {
+	dir      string
+	readOnly bool
+}
+
+func newCache(dir string) *cache {
+	if len(dir) == 0 {
+		return nil
+	}
+	return &cache{dir: dir}
+}
+
+// key returns the cache key for the parts
+func (c *cache) key(parts ...[]byte) string {
+	if c == nil {
+		return ""
+	}
+
+	h := sha256.New()
+	h.Write([]byte(cacheVersion))
+
+	for _, part := range parts {
+		var size [8]byte
+		binary.LittleEndian.PutUint64(size[:], uint64(len(part)))
+		h.Write(size[:])
+		h.Write(part)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *cache) path(key string) string {
+	return filepath.Join(c.dir, key[:2], key)
+}
+
+// get returns the data stored for key
+func (c *cache) get(key string) (b []byte, ok bool) {
+	if c != nil {
+		var err error
+		b, err = ioutil.ReadFile(c.path(key))
+		ok = err == nil
+	}
+	return
+}
+
+// put stores data for key
+func (c *cache) put(key string, data []byte) (err error) {
+	if c != nil && !c.readOnly {
+		var tmp *os.File
+
+		name := c.path(key)
+		dir := filepath.Dir(name)
+
+		if err = os.MkdirAll(dir, os.ModePerm); err == nil {
+			tmp, err = ioutil.TempFile(dir, "tmp-")
+		}
+
+		if err == nil {
+			_, err = tmp.Write(data)
+
+			if e := tmp.Close(); err == nil {
+				err = e
+			}
+
+			// rename so concurrent generators never see partial entries
+			if err == nil {
+				err = os.Rename(tmp.Name(), name)
+			}
+
+			if err != nil {
+				os.Remove(tmp.Name())
+			}
+		}
+	}
+	return
+}
+
+// process returns the cached result for key if present, otherwise runs fn
+// and stores the result
+func (c *cache) process(key string, fn func() ([]byte, error)) (b []byte, err error) {
+	var ok bool
+
+	if b, ok = c.get(key); !ok {
+		if b, err = fn(); err == nil {
+			err = c.put(key, b)
+		}
+	}
+
+	return
+}
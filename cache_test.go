@@ -0,0 +1,71 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCache(t *testing.T) {
+	tmpdir, _ := ioutil.TempDir("", "cache-test")
+	defer os.RemoveAll(tmpdir)
+
+	c := newCache(filepath.Join(tmpdir, "cache"))
+	r := &cache{dir: c.dir, readOnly: true}
+
+	if newCache("") != nil {
+		t.Errorf("Cache without a directory should be nil")
+	}
+
+	if c.key([]byte("ab"), []byte("c")) == c.key([]byte("a"), []byte("bc")) {
+		t.Errorf("Keys for different parts should differ")
+	}
+
+	for _, test := range []struct {
+		name   string
+		cache  *cache
+		key    string
+		calls  int
+		hasErr bool
+		err    error
+	}{
+		{"Miss", c, c.key([]byte("data")), 1, false, nil},
+		{"Hit", c, c.key([]byte("data")), 0, false, nil},
+		{"Error", c, c.key([]byte("other")), 1, true, errors.New("failed")},
+		{"Error Not Stored", c, c.key([]byte("other")), 1, false, nil},
+		{"Read Only Hit", r, c.key([]byte("data")), 0, false, nil},
+		{"Read Only Miss", r, c.key([]byte("fresh")), 1, false, nil},
+		{"Read Only Not Stored", r, c.key([]byte("fresh")), 1, false, nil},
+		{"Nil", nil, "", 1, false, nil},
+		{"Nil Again", nil, "", 1, false, nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			calls := 0
+			b, err := test.cache.process(test.key, func() ([]byte, error) {
+				calls++
+				return []byte("result"), test.err
+			})
+
+			if calls != test.calls {
+				t.Errorf("Process called %d times expected %d", calls, test.calls)
+			}
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("Process did not return an error")
+				} else if !reflect.DeepEqual(b, []byte("result")) {
+					t.Errorf("Did not get expected got (%s) expected (result)", b)
+				}
+			} else if !test.hasErr {
+				t.Errorf("Process returned unexpected error %v", err)
+			}
+		})
+	}
+}
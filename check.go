@@ -0,0 +1,209 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// StaleError is returned by Check when the generated files are out of date.
+type StaleError struct {
+	// Files is the list of output files that differ.
+	Files []string
+	// Added is the list of embedded names missing from the output.
+	Added []string
+	// Removed is the list of embedded names in the output that are no longer embedded.
+	Removed []string
+	// Changed is the list of embedded names whose contents or attributes differ.
+	Changed []string
+}
+
+func (e *StaleError) Error() string {
+	var b strings.Builder
+
+	b.WriteString("generated files out of date: ")
+	b.WriteString(strings.Join(e.Files, ", "))
+
+	for _, list := range []struct {
+		prefix string
+		names  []string
+	}{
+		{"added", e.Added},
+		{"removed", e.Removed},
+		{"changed", e.Changed},
+	} {
+		for _, name := range list.names {
+			b.WriteString("\n\t")
+			b.WriteString(list.prefix)
+			b.WriteString(" ")
+			b.WriteString(name)
+		}
+	}
+
+	return b.String()
+}
+
+// Check runs the generator in memory and compares the result with the existing
+// output files, nothing is written, the cache in Config.CacheDir is only read.
+// It returns a *StaleError if the output files are missing or out of date.
+func (config *Config) Check() (err error) {
+	var gen generate
+
+	if config.Binary {
+		return errors.New("Check is not supported for Binary output")
+	}
+
+	gen.check = &checker{}
+
+	if err = gen.generate(config); err == nil {
+		err = gen.check.err(config.Output + ".go")
+	}
+
+	return
+}
+
+// checker records the output files that differ
+type checker struct {
+	files []string
+	old   map[string][]byte
+	new   map[string][]byte
+}
+
+func (c *checker) add(name string, old []byte, new []byte) {
+	if c.old == nil {
+		c.old = make(map[string][]byte)
+		c.new = make(map[string][]byte)
+	}
+
+	c.files = append(c.files, name)
+	c.old[name] = old
+	c.new[name] = new
+}
+
+// err returns a *StaleError listing the differences, main is the
+// output file containing the file table
+func (c *checker) err(main string) error {
+	if len(c.files) == 0 {
+		return nil
+	}
+
+	e := &StaleError{}
+
+	for _, name := range c.files {
+		e.Files = append(e.Files, filepath.Base(name))
+	}
+
+	if _, ok := c.new[main]; ok {
+		old, new := assetEntries(c.old[main]), assetEntries(c.new[main])
+
+		for name, entry := range new {
+			if previous, ok := old[name]; !ok {
+				e.Added = append(e.Added, name)
+			} else if previous != entry {
+				e.Changed = append(e.Changed, name)
+			}
+		}
+
+		for name := range old {
+			if _, ok := new[name]; !ok {
+				e.Removed = append(e.Removed, name)
+			}
+		}
+
+		sort.Strings(e.Added)
+		sort.Strings(e.Removed)
+		sort.Strings(e.Changed)
+	}
+
+	return e
+}
+
+// entryCalls are the calls of the generated init that store the entries of a file
+var entryCalls = map[string]bool{"AddFile": true, "SetIntegrity": true, "AddEncoding": true}
+
+// assetEntries returns the calls storing each file of a generated file by name.
+// The arguments are the comments showing their values, offsets are left out as
+// they change when any other file changes.
+func assetEntries(b []byte) map[string]string {
+	list := make(map[string]string)
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "", b, parser.ParseComments)
+	if err != nil {
+		return list
+	}
+
+	var comments []*ast.Comment
+	for _, group := range file.Comments {
+		comments = append(comments, group.List...)
+	}
+
+	// comment returns the text of the last comment between pos and end
+	comment := func(pos token.Pos, end token.Pos) (text string, ok bool) {
+		i := sort.Search(len(comments), func(i int) bool { return comments[i].End() > end })
+		if i > 0 && comments[i-1].Pos() > pos {
+			text, ok = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(comments[i-1].Text, "/*"), "*/")), true
+		}
+		return
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !entryCalls[sel.Sel.Name] {
+			return true
+		}
+
+		var (
+			name  string
+			entry strings.Builder
+		)
+
+		entry.WriteString(sel.Sel.Name)
+		pos := call.Lparen
+
+		for i, arg := range call.Args {
+			value, ok := comment(pos, arg.Pos())
+
+			if !ok {
+				switch arg := arg.(type) {
+				case *ast.SliceExpr:
+					// Offsets in to the data
+				case *ast.BasicLit:
+					if value, err = strconv.Unquote(arg.Value); err != nil {
+						value = arg.Value
+					}
+				default:
+					value = string(b[fset.Position(arg.Pos()).Offset:fset.Position(arg.End()).Offset])
+				}
+			}
+
+			if i == 0 {
+				name = value
+			}
+
+			entry.WriteString(" ")
+			entry.WriteString(value)
+			pos = arg.End()
+		}
+
+		list[name] += entry.String() + "\n"
+
+		return true
+	})
+
+	return list
+}
@@ -0,0 +1,157 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	base, err := createFs()
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	config := New()
+	config.Output = filepath.Join(base, "assets", "files")
+	config.Files = []string{filepath.Join(base, "www")}
+	config.ModifyTime = "1"
+	config.CacheDir = filepath.Join(base, "cache")
+
+	if err := config.Check(); err == nil {
+		t.Errorf("Check did not return an error before generate")
+	} else if e, ok := err.(*StaleError); !ok {
+		t.Errorf("Check did not return a StaleError got %v", err)
+	} else if expect := []string{"files_data.s", "files.go", "files_test.go"}; !reflect.DeepEqual(e.Files, expect) {
+		t.Errorf("Did not get expected files got (%v) expected (%v)", e.Files, expect)
+	}
+
+	if _, err := os.Stat(config.Output + ".go"); !os.IsNotExist(err) {
+		t.Errorf("Check wrote output files")
+	}
+
+	if _, err := os.Stat(config.CacheDir); !os.IsNotExist(err) {
+		t.Errorf("Check wrote cache entries")
+	}
+
+	if err := config.Generate(); err != nil {
+		t.Fatalf("Generate returned unexpected error %v", err)
+	}
+
+	for _, test := range []struct {
+		name   string
+		change func() error
+		expect *StaleError
+	}{
+		{
+			name:   "Unchanged",
+			change: func() error { return nil },
+		},
+		{
+			name: "Changed",
+			change: func() error {
+				return ioutil.WriteFile(filepath.Join(base, "www", "index.html"), []byte("<html><body></body></html>"), os.ModePerm)
+			},
+			expect: &StaleError{
+				Files:   []string{"files_data.s", "files.go"},
+				Changed: []string{"/index.html"},
+			},
+		},
+		{
+			name: "Added Removed",
+			change: func() error {
+				return os.Rename(filepath.Join(base, "www", "scripts", "init.js"), filepath.Join(base, "www", "scripts", "main.js"))
+			},
+			expect: &StaleError{
+				Files:   []string{"files_data.s", "files.go"},
+				Added:   []string{"/scripts/main.js"},
+				Removed: []string{"/scripts/init.js"},
+				Changed: []string{"/index.html"},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.change(); err != nil {
+				t.Fatalf("unable to change fs %v", err)
+			}
+
+			err := config.Check()
+
+			if test.expect == nil {
+				if err != nil {
+					t.Errorf("Check returned unexpected error %v", err)
+				}
+			} else if !reflect.DeepEqual(err, test.expect) {
+				t.Errorf("Did not get expected got (%v) expected (%v)", err, test.expect)
+			}
+		})
+	}
+
+	t.Run("Binary", func(t *testing.T) {
+		config.Binary = true
+		defer func() { config.Binary = false }()
+
+		if err := config.Check(); err == nil {
+			t.Errorf("Check did not return an error")
+		}
+	})
+}
+
+func TestAssetEntries(t *testing.T) {
+	const (
+		generated = `package assets
+
+func init() {
+	FS.AddFile( /* /index.html */ str[10:21],
+		/* index.html */ str[11:21],
+		"",
+		13, 1,
+		/* text/html; charset=utf-8 */ str[21:45],
+		/* tag */ str[45:48],
+		false, bytes[0:10], str[0:10])
+	integrity.SetIntegrity( /* /index.html */ str[10:21],
+		/* sha256-abc */ str[48:58])
+}
+`
+		// The same calls at other offsets laid out differently
+		reformatted = `package assets
+
+func init() {
+	FS.AddFile(/* /index.html */ str[20:31], /* index.html */ str[21:31], "", 13, 1, /* text/html; charset=utf-8 */ str[31:55], /* tag */ str[55:58], false, bytes[5:15], str[5:15])
+	integrity.SetIntegrity(/* /index.html */ str[20:31], /* sha256-abc */ str[58:68])
+	FS.AddFolder( /* / */ str[0:1], /* / */ str[0:1], "", 1, /* /index.html */ str[20:31])
+}
+`
+	)
+
+	entries := assetEntries([]byte(generated))
+
+	if _, ok := entries["/index.html"]; !ok || len(entries) != 1 {
+		t.Fatalf("Did not get expected entries got (%v)", entries)
+	}
+
+	if other := assetEntries([]byte(reformatted)); !reflect.DeepEqual(entries, other) {
+		t.Errorf("Did not get the same entries got (%v) expected (%v)", other, entries)
+	}
+
+	changed := strings.Replace(generated, "sha256-abc", "sha256-xyz", 1)
+	if other := assetEntries([]byte(changed)); reflect.DeepEqual(entries, other) {
+		t.Errorf("Did not get changed entries for a changed integrity")
+	}
+
+	if other := assetEntries([]byte("not go")); len(other) != 0 {
+		t.Errorf("Did not get empty entries for invalid source got (%v)", other)
+	}
+}
@@ -4,10 +4,12 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	syslog "log"
 	"os"
+	"strings"
 
 	"github.com/inabyte/embed"
 )
@@ -24,24 +26,47 @@ var (
 
 // main generate the code
 func main() {
+	var (
+		manifest string
+		check    bool
+	)
+
 	conf := embed.New()
 
 	f.Usage = func() {
 		fmt.Fprintf(f.Output(), `Usage:  %s [<options>] <files>
-Where: <files> list of files and/or folders to embed
+Where: <files> list of files, folders and/or globs to embed, optional if a config manifest lists them
        <options> one or more of the following
 `, os.Args[0])
 		f.PrintDefaults()
 	}
 
+	f.StringVar(&manifest, "config", manifest, "JSON manifest file to read configuration from, other options override the manifest.")
+	f.BoolVar(&check, "check", check, "If true, do not write files, exit with an error listing the changes if the output is out of date.")
 	f.StringVar(&conf.Output, "o", conf.Output, "Output files base.")
 	f.StringVar(&conf.Package, "pkg", conf.Package, "Package name.")
 	f.StringVar(&conf.BuildTags, "tags", conf.BuildTags, "Build tags.")
-	f.StringVar(&conf.Ignore, "ignore", conf.Ignore, "Regexp for files we should ignore (for example \\\\.DS_Store).")
+	f.StringVar(&conf.Ignore, "ignore", conf.Ignore, "Regexp for files and directories we should ignore (for example \\\\.DS_Store).")
 	f.StringVar(&conf.Include, "include", conf.Include, "Regexp for files to include. Only files that match will be included.")
+	f.StringVar(&conf.Fingerprint, "fingerprint", conf.Fingerprint, "Regexp for embedded names to store with a hash of the contents added (for example ^/assets/).")
+	f.StringVar(&conf.Digest, "digest", conf.Digest, "Hash algorithm (sha1, sha256, sha384 or sha512) used for file tags, defaults to sha1.")
+	f.StringVar(&conf.Integrity, "integrity", conf.Integrity, "Comma list of hash algorithms (sha256, sha384 or sha512) for subresource integrity.")
+	f.BoolVar(&conf.OmitEmptyDirs, "omitemptydirs", conf.OmitEmptyDirs, "If true, leave out directories without any embedded files.")
+	f.BoolVar(&conf.GitIgnore, "gitignore", conf.GitIgnore, "If true, honor .gitignore files as well as .embedignore files, .gitignore files are then not embedded.")
+	f.StringVar(&conf.Symlinks, "symlinks", conf.Symlinks, "How symbolic links are handled, follow (default), skip or link to store them as links.")
+	f.Var(&rewrites{list: &conf.Rewrite}, "rewrite", "Rewrite rule pattern=replacement, a regexp matched against embedded names and its replacement (for example ^/dist/(.*)=/static/$1), may be repeated.")
+	f.StringVar(&conf.Conflict, "conflict", conf.Conflict, "What to do when files are stored with the same name, error (default), first or last to keep that file.")
 	f.StringVar(&conf.Minify, "minify", conf.Minify, "Comma list of mimetypes to minify")
-	f.StringVar(&conf.ModifyTime, "modifytime", conf.ModifyTime, "Unix timestamp to override as modification time for all files.")
+	f.BoolVar(&conf.StrictMinify, "strictminify", conf.StrictMinify, "If true, stop with an error when a file can not be minified instead of a warning.")
+	f.StringVar(&conf.ModifyTime, "modifytime", conf.ModifyTime, "Unix timestamp or RFC 3339 time to override as modification time for all files.")
+	f.BoolVar(&conf.GitModifyTime, "gittime", conf.GitModifyTime, "If true, use the last git commit time of files as modification time.")
+	f.StringVar(&conf.CacheDir, "cache", conf.CacheDir, "Directory to cache minified and compressed files between runs.")
+	f.IntVar(&conf.Workers, "workers", conf.Workers, "Number of files to process concurrently, defaults to the number of CPUs.")
 	f.BoolVar(&conf.DisableCompression, "no-compress", conf.DisableCompression, "If true, do not compress files.")
+	f.StringVar(&conf.NoCompressTypes, "nocompresstypes", conf.NoCompressTypes, "Comma list of mimetypes, or type/* for all subtypes, never compressed.")
+	f.IntVar(&conf.CompressMinSize, "compressminsize", conf.CompressMinSize, "Size in bytes files must have to be compressed.")
+	f.Float64Var(&conf.CompressMinSavings, "compressminsavings", conf.CompressMinSavings, "Fraction of the size (for example 0.1) compression must save to store the compressed contents.")
+	f.BoolVar(&conf.Precompressed, "precompressed", conf.Precompressed, "If true, store siblings such as app.js.gz or app.js.br as the encoded contents of app.js.")
 	f.BoolVar(&conf.Go, "go", conf.Go, "write only go files")
 	f.BoolVar(&conf.FileServer, "fileserver", conf.Binary, "produce http server code")
 	f.BoolVar(&conf.Binary, "binary", conf.Binary, "produce self-contained extractor/http server binary (<output> will become the binary name)")
@@ -49,10 +74,31 @@ Where: <files> list of files and/or folders to embed
 	f.BoolVar(&conf.NoLocalFS, "nolocalfs", conf.NoLocalFS, "if true, do not store local file system paths.")
 	f.Parse(os.Args[1:])
 
-	conf.Files = f.Args()
+	if len(manifest) > 0 {
+		loaded, err := embed.LoadConfig(manifest)
+		if err != nil {
+			showError(f, err)
+			return
+		}
+
+		// Parse again so options on the command line override the manifest
+		*conf = *loaded
+		f.Parse(os.Args[1:])
+	}
 
-	if len(f.Args()) < 1 {
+	conf.Logf = func(format string, v ...interface{}) {
+		log.Print(fmt.Sprintf(format, v...))
+	}
+
+	conf.Files = append(conf.Files, f.Args()...)
+
+	if len(conf.Files) < 1 && len(conf.Sources) < 1 {
 		showError(f, "No files/folders specified")
+	} else if check {
+		if err := conf.Check(); err != nil {
+			log.Print(err)
+			myExit(1)
+		}
 	} else {
 		if err := conf.Generate(); err != nil {
 			showError(f, err)
@@ -60,6 +106,34 @@ Where: <files> list of files and/or folders to embed
 	}
 }
 
+// rewrites collects the rewrite rules given on the command line
+type rewrites struct {
+	list *[]embed.Rewrite
+}
+
+func (r *rewrites) String() string {
+	var list []string
+
+	if r.list != nil {
+		for _, entry := range *r.list {
+			list = append(list, entry.Pattern+"="+entry.Replacement)
+		}
+	}
+
+	return strings.Join(list, " ")
+}
+
+func (r *rewrites) Set(value string) error {
+	i := strings.Index(value, "=")
+	if i < 0 {
+		return errors.New("must be pattern=replacement")
+	}
+
+	*r.list = append(*r.list, embed.Rewrite{Pattern: value[:i], Replacement: value[i+1:]})
+
+	return nil
+}
+
 func showError(f *flag.FlagSet, v ...interface{}) {
 	log.Print(v...)
 	f.Usage()
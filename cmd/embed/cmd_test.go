@@ -22,6 +22,10 @@ func TestMain(t *testing.T) {
 		{"no args", []string{"go-embed"}},
 		{"bad args", []string{"go-embed", "-h"}},
 		{"file", []string{"go-embed", "files"}},
+		{"bad config", []string{"go-embed", "-config", "missing.json"}},
+		{"check", []string{"go-embed", "-check", "-o", "missing/files", "files"}},
+		{"rewrite", []string{"go-embed", "-rewrite", "^/(.*)=/static/$1", "-rewrite", "x=y", "files"}},
+		{"bad rewrite", []string{"go-embed", "-rewrite", "static", "files"}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			os.Args = test.args
@@ -0,0 +1,159 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Command runs an external command on the files matching a glob pattern
+type Command struct {
+	// Pattern is the glob pattern matched against embedded names without the
+	// leading slash, a pattern without a slash is matched against the base name.
+	Pattern string `json:"pattern"`
+	// Command is the command line, split at spaces and run without a shell, its
+	// standard output is embedded. {in} is replaced by the path of the file and
+	// {name} by the embedded name, without {in} the file is written to standard input.
+	Command string `json:"command"`
+	// Ext, if set, replaces the extension of the embedded name (for example .css).
+	Ext string `json:"ext"`
+	// Timeout is how long the command may run (for example 30s), one minute if
+	// not set.
+	Timeout string `json:"timeout"`
+	// Cache, if true, keeps the output in Config.CacheDir keyed on the command and
+	// the file contents, leave it off for commands that read other files too.
+	Cache bool `json:"cache"`
+}
+
+const defaultCommandTimeout = time.Minute
+
+type command struct {
+	args    []string
+	ext     string
+	timeout time.Duration
+	cache   *cache
+}
+
+// newCommand checks the command entry, c is the cache used if the entry allows it
+func newCommand(entry Command, c *cache) (cmd *command, err error) {
+	cmd = &command{
+		args:    strings.Fields(entry.Command),
+		ext:     entry.Ext,
+		timeout: defaultCommandTimeout,
+	}
+
+	if entry.Cache {
+		cmd.cache = c
+	}
+
+	if len(cmd.ext) > 0 && !strings.HasPrefix(cmd.ext, ".") {
+		cmd.ext = "." + cmd.ext
+	}
+
+	if len(cmd.args) == 0 {
+		err = fmt.Errorf("command for %s is empty", entry.Pattern)
+	} else if len(entry.Timeout) > 0 {
+		if cmd.timeout, err = time.ParseDuration(entry.Timeout); err == nil && cmd.timeout <= 0 {
+			err = errors.New("must be positive")
+		}
+
+		if err != nil {
+			err = fmt.Errorf("command %s timeout %v", entry.Command, err)
+		}
+	}
+
+	if err != nil {
+		cmd = nil
+	}
+
+	return
+}
+
+// run runs the command on the file data
+func (cmd *command) run(f *file, data []byte) (*Transformed, error) {
+	key := cmd.cache.key([]byte("command"), []byte(strings.Join(cmd.args, "\x00")), []byte(f.name), data)
+
+	out, err := cmd.cache.process(key, func() ([]byte, error) {
+		return cmd.exec(f, data)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Transformed{Data: out}
+
+	if len(cmd.ext) > 0 {
+		res.Name = strings.TrimSuffix(f.name, path.Ext(f.name)) + cmd.ext
+	}
+
+	return res, nil
+}
+
+// exec runs the command on the file and returns its standard output
+func (cmd *command) exec(f *file, data []byte) (out []byte, err error) {
+	var in string
+
+	stdin := true
+	for _, arg := range cmd.args {
+		if strings.Contains(arg, "{in}") {
+			stdin = false
+		}
+	}
+
+	// Files not on the local file system, or changed by an earlier transform,
+	// are written to a temporary file
+	if in = f.localPath; !stdin && (len(in) == 0 || !bytes.Equal(f.sum(data), f.readSum)) {
+		var dir string
+
+		if dir, err = ioutil.TempDir("", "embed-command"); err == nil {
+			defer os.RemoveAll(dir)
+
+			in = filepath.Join(dir, path.Base(f.name))
+			err = ioutil.WriteFile(in, data, 0600)
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	replacer := strings.NewReplacer("{in}", in, "{name}", f.name)
+
+	args := make([]string, len(cmd.args))
+	for i, arg := range cmd.args {
+		args[i] = replacer.Replace(arg)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cmd.timeout)
+	defer cancel()
+
+	c := exec.CommandContext(ctx, args[0], args[1:]...)
+	if stdin {
+		c.Stdin = bytes.NewReader(data)
+	}
+
+	if out, err = c.Output(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			err = fmt.Errorf("%s timed out after %v", args[0], cmd.timeout)
+		} else if e, ok := err.(*exec.ExitError); ok && len(e.Stderr) > 0 {
+			err = fmt.Errorf("%s failed: %s", args[0], strings.TrimSpace(string(e.Stderr)))
+		} else {
+			err = fmt.Errorf("%s failed: %v", args[0], err)
+		}
+	}
+
+	return
+}
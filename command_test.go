@@ -0,0 +1,192 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestCommands(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not installed")
+	}
+
+	base, err := ioutil.TempDir("", "command-test")
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	// The script counts its runs and prints its input upper case
+	script := filepath.Join(base, "upper.sh")
+	count := filepath.Join(base, "count")
+
+	if err == nil {
+		err = ioutil.WriteFile(script, []byte("echo run >> "+count+"\ntr a-z A-Z < \"$1\"\n"), 0600)
+	}
+
+	if err == nil {
+		err = os.MkdirAll(filepath.Join(base, "www", "sass"), os.ModePerm)
+	}
+
+	if err == nil {
+		err = ioutil.WriteFile(filepath.Join(base, "www", "sass", "main.scss"), []byte("a { b: c }"), os.ModePerm)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	files := fstest.MapFS{
+		"index.html":     &fstest.MapFile{Data: []byte("index")},
+		"sass/site.scss": &fstest.MapFile{Data: []byte("body { color: red }")},
+	}
+
+	for _, test := range []struct {
+		name     string
+		commands []Command
+		sources  []Source
+		expect   map[string]string
+		runs     int
+		hasErr   bool
+	}{
+		{
+			name:     "Stdin",
+			commands: []Command{{Pattern: "*.scss", Command: "tr a-z A-Z", Ext: "css"}},
+			sources:  []Source{{FS: files}},
+			expect: map[string]string{
+				"/index.html":    "index",
+				"/sass/site.css": "BODY { COLOR: RED }",
+			},
+		},
+		{
+			name:     "Temporary File",
+			commands: []Command{{Pattern: "sass/*.scss", Command: "sh " + script + " {in}", Ext: ".css"}},
+			sources:  []Source{{FS: files}},
+			expect: map[string]string{
+				"/index.html":    "index",
+				"/sass/site.css": "BODY { COLOR: RED }",
+			},
+			runs: 1,
+		},
+		{
+			name:     "Local File",
+			commands: []Command{{Pattern: "*.scss", Command: "sh " + script + " {in}", Ext: ".css", Cache: true}},
+			sources:  []Source{{Path: filepath.Join(base, "www")}},
+			expect: map[string]string{
+				"/sass/main.css": "A { B: C }",
+			},
+			runs: 1,
+		},
+		{
+			name: "After Command",
+			commands: []Command{
+				{Pattern: "*.scss", Command: "tr a x"},
+				{Pattern: "*.scss", Command: "sh " + script + " {in}", Ext: ".css"},
+			},
+			sources: []Source{{Path: filepath.Join(base, "www")}},
+			expect: map[string]string{
+				"/sass/main.css": "X { B: C }",
+			},
+			runs: 1,
+		},
+		{
+			name:     "Cached",
+			commands: []Command{{Pattern: "*.scss", Command: "sh " + script + " {in}", Ext: ".css", Cache: true}},
+			sources:  []Source{{Path: filepath.Join(base, "www")}},
+			expect: map[string]string{
+				"/sass/main.css": "A { B: C }",
+			},
+		},
+		{
+			name:     "Same Name",
+			commands: []Command{{Pattern: "*.html", Command: "echo {name}"}},
+			sources:  []Source{{FS: files}},
+			expect: map[string]string{
+				"/index.html":     "/index.html\n",
+				"/sass/site.scss": "body { color: red }",
+			},
+		},
+		{
+			name:     "Exit Status",
+			commands: []Command{{Pattern: "*.scss", Command: "false"}},
+			sources:  []Source{{FS: files}},
+			hasErr:   true,
+		},
+		{
+			name:     "Timeout",
+			commands: []Command{{Pattern: "*.scss", Command: "sleep 5", Timeout: "50ms"}},
+			sources:  []Source{{FS: files}},
+			hasErr:   true,
+		},
+		{
+			name:     "Bad Timeout",
+			commands: []Command{{Pattern: "*.scss", Command: "cat", Timeout: "soon"}},
+			sources:  []Source{{FS: files}},
+			hasErr:   true,
+		},
+		{
+			name:     "Empty",
+			commands: []Command{{Pattern: "*.scss"}},
+			sources:  []Source{{FS: files}},
+			hasErr:   true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var gen generate
+
+			os.Remove(count)
+
+			config := New()
+			config.Output = filepath.Join(base, "assets", "files")
+			config.CacheDir = filepath.Join(base, "cache")
+			config.Commands = test.commands
+			config.DisableCompression = true
+			config.Minify = ""
+			config.Sources = test.sources
+
+			err := gen.generate(config)
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("Generate did not return an error")
+				}
+			} else if !test.hasErr {
+				t.Fatalf("Generate returned unexpected error %v", err)
+			}
+
+			if err == nil {
+				tags := make(map[string]string)
+				for _, f := range gen.Files {
+					tags[f.name] = f.tag
+				}
+
+				expect := make(map[string]string)
+				for name, contents := range test.expect {
+					sum := sha1.Sum([]byte(contents))
+					expect[name] = base64.RawURLEncoding.EncodeToString(sum[:])
+				}
+
+				if !reflect.DeepEqual(tags, expect) {
+					t.Errorf("Did not get expected files got (%v) expected (%v)", tags, test.expect)
+				}
+
+				b, _ := ioutil.ReadFile(count)
+				if runs := strings.Count(string(b), "run"); runs != test.runs {
+					t.Errorf("Did not get expected command runs got (%d) expected (%d)", runs, test.runs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+)
+
+// compressPolicy decides which files are compressed
+type compressPolicy struct {
+	// skip are the media types, or type/* for all subtypes, never compressed
+	skip       map[string]bool
+	minSize    int
+	minSavings float64
+}
+
+// newCompressPolicy returns the policy for the comma separated list of mime types
+// not to compress, the minimum size and the minimum fraction of the size saved
+func newCompressPolicy(types string, minSize int, minSavings float64) (*compressPolicy, error) {
+	if minSize < 0 {
+		return nil, fmt.Errorf("CompressMinSize %d is negative", minSize)
+	}
+
+	if minSavings < 0 || minSavings >= 1 {
+		return nil, fmt.Errorf("CompressMinSavings %v is not between 0 and 1", minSavings)
+	}
+
+	p := &compressPolicy{
+		skip:       make(map[string]bool),
+		minSize:    minSize,
+		minSavings: minSavings,
+	}
+
+	for _, entry := range strings.Split(types, ",") {
+		if s, _, e := mime.ParseMediaType(entry); e == nil {
+			p.skip[s] = true
+		}
+	}
+
+	return p, nil
+}
+
+// allow returns true if a file of the mime type and size should be compressed
+func (p *compressPolicy) allow(mimeType string, size int) bool {
+	if p == nil {
+		return true
+	}
+
+	if size < p.minSize {
+		return false
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(mimeType); err == nil {
+		if p.skip[mediaType] || p.skip[mediaType[:strings.IndexByte(mediaType, '/')+1]+"*"] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// worth returns true if compressing size bytes to compressed bytes saves enough
+func (p *compressPolicy) worth(compressed int, size int) bool {
+	if p == nil {
+		return compressed < size
+	}
+	return compressed < size && float64(size-compressed) >= p.minSavings*float64(size)
+}
@@ -0,0 +1,74 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"testing"
+)
+
+func TestCompressPolicy(t *testing.T) {
+	policy, err := newCompressPolicy(New().NoCompressTypes+",application/x-custom", 100, 0.2)
+
+	if err != nil {
+		t.Fatalf("newCompressPolicy returned unexpected error %v", err)
+	}
+
+	for _, test := range []struct {
+		name     string
+		mimeType string
+		size     int
+		expect   bool
+	}{
+		{"HTML", "text/html; charset=utf-8", 1000, true},
+		{"PNG", "image/png", 1000, false},
+		{"WOFF2", "font/woff2", 1000, false},
+		{"Video", "video/mp4", 1000, false},
+		{"Custom", "application/x-custom", 1000, false},
+		{"SVG", "image/svg+xml; charset=utf-8", 1000, true},
+		{"Tiny", "text/html; charset=utf-8", 99, false},
+		{"Minimum", "text/html; charset=utf-8", 100, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if allow := policy.allow(test.mimeType, test.size); allow != test.expect {
+				t.Errorf("Did not get expected for %s size %d got (%v) expected (%v)", test.mimeType, test.size, allow, test.expect)
+			}
+		})
+	}
+
+	for _, test := range []struct {
+		name       string
+		policy     *compressPolicy
+		compressed int
+		size       int
+		expect     bool
+	}{
+		{"Enough", policy, 800, 1000, true},
+		{"Not Enough", policy, 801, 1000, false},
+		{"Default Smaller", nil, 999, 1000, true},
+		{"Default Larger", nil, 1000, 1000, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if worth := test.policy.worth(test.compressed, test.size); worth != test.expect {
+				t.Errorf("Did not get expected for %d of %d got (%v) expected (%v)", test.compressed, test.size, worth, test.expect)
+			}
+		})
+	}
+
+	for _, test := range []struct {
+		name       string
+		minSize    int
+		minSavings float64
+	}{
+		{"Negative Size", -1, 0},
+		{"Negative Savings", 0, -0.1},
+		{"All Savings", 0, 1},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := newCompressPolicy("", test.minSize, test.minSavings); err == nil {
+				t.Errorf("newCompressPolicy did not return an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"strings"
+)
+
+// digestType a hash algorithm for file tags and the code to create it in generated tests
+type digestType struct {
+	new     func() hash.Hash
+	pkg     string
+	newFunc string
+}
+
+// digestTypes the hash algorithms allowed for file tags
+var digestTypes = map[string]digestType{
+	"sha1":   {sha1.New, "crypto/sha1", "sha1.New"},
+	"sha256": {sha256.New, "crypto/sha256", "sha256.New"},
+	"sha384": {sha512.New384, "crypto/sha512", "sha512.New384"},
+	"sha512": {sha512.New, "crypto/sha512", "sha512.New"},
+}
+
+// parseDigest returns the digest type named, sha1 if name is empty
+func parseDigest(name string) (digest digestType, err error) {
+	var ok bool
+
+	if name = strings.ToLower(strings.TrimSpace(name)); len(name) == 0 {
+		name = "sha1"
+	}
+
+	if digest, ok = digestTypes[name]; !ok {
+		err = fmt.Errorf("Digest %q is not one of sha1, sha256, sha384 or sha512", name)
+	}
+
+	return
+}
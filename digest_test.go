@@ -0,0 +1,47 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestParseDigest(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		digest string
+		pkg    string
+		expect string
+		hasErr bool
+	}{
+		{"Default", "", "crypto/sha1", "a9993e364706816aba3e25717850c26c9cd0d89d", false},
+		{"SHA256", " SHA256 ", "crypto/sha256", "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", false},
+		{"SHA384", "sha384", "crypto/sha512", "cb00753f45a35e8bb5a03d699ac65007272c32ab0eded1631a8b605a43ff5bed8086072ba1e7cc2358baeca134c825a7", false},
+		{"Bad", "md5", "", "", true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			digest, err := parseDigest(test.digest)
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("parseDigest did not return an error")
+				} else {
+					h := digest.new()
+					h.Write([]byte("abc"))
+					if sum := hex.EncodeToString(h.Sum(nil)); sum != test.expect {
+						t.Errorf("Did not get expected sum got (%s) expected (%s)", sum, test.expect)
+					}
+				}
+			} else if !test.hasErr {
+				t.Errorf("parseDigest returned unexpected error %v", err)
+			}
+
+			if digest.pkg != test.pkg {
+				t.Errorf("Did not get expected package got (%s) expected (%s)", digest.pkg, test.pkg)
+			}
+		})
+	}
+}
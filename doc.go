@@ -16,14 +16,16 @@ Features
 	- outputs `gofmt`ed and `lint`ed Go code.
 	- produces go-gettable go and go assembly sources with `go generate`.
 	- keeps data and strings in read-only section of the binary.
-	- minify HTML, CSS, and JavaScript files.
-	- compress compressible files with `gzip`.
+	- stores identical contents, such as vendor copies, once.
+	- minify HTML, CSS, JavaScript, SVG, JSON and XML files.
+	- compress compressible files with `gzip` and any other registered encoders.
 	- provides [http.FileSystem](https://golang.org/pkg/net/http/#FileSystem) API.
+	- provides [io/fs.FS](https://golang.org/pkg/io/fs/#FS) API.
 	- provides [http.Handler](https://golang.org/pkg/net/http/#Handler) handler
 	  (if requested),
 	- generates a test code.
 	- allows access to compressed data.
-	- serving gzip files to clients that accept compressed content.
+	- serving the smallest encoding of files clients accept.
 	- calculate checksums for serving Etag-based conditional requests.
 	- zero dependencies on packages outside the standard library
 	  (if requested),
@@ -36,6 +38,252 @@ everything under the folder ./files/html/embed as /embed/... for example
 ./files/html/embed/index.html will be stored as /embed/index.html.
 There is no limit to the number you can specify but the resultant file system must be unique,
 if the processing produces two files in the same location Generate() exit with an error.
+A file specified without a prefix marker is stored under its name.
+
+Config.Sources adds file systems to embed, any fs.FS can be used such as a zip file,
+an in-memory tree or another embedded package. The Path of a Source is a path within
+the file system and uses the same prefix marker.
+
+Manifest
+
+The configuration can be read from a JSON manifest with LoadConfig (or embed -config),
+the keys are the Config fields in camel case and relative paths are relative to the
+directory containing the manifest. Each entry in sources can have a mount point,
+include and ignore glob patterns and minify or compress overrides.
+
+	{
+	    "output": "static",
+	    "package": "main",
+	    "mimeTypes": {".webmanifest": "application/manifest+json"},
+	    "sources": [
+	        {"path": "web/dist", "mount": "/static", "ignore": ["*.map"]},
+	        {"path": "web/fonts", "mount": "/fonts", "compress": false}
+	    ]
+	}
+
+Incremental Generation
+
+Output files are only written when their contents change, so unchanged assets keep
+the file modification times and the Go build cache valid. Set Config.CacheDir (or
+embed -cache) to keep minified and compressed contents between runs, files are then
+only processed again when their contents or processing options change.
+
+Config.Check (or embed -check) runs the generator in memory and returns a *StaleError
+listing the output files and embedded names that differ, use it in CI to catch
+assets changed without running go generate.
+
+Files are minified and compressed concurrently on Config.Workers goroutines (embed
+-workers), the output does not depend on the number of workers.
+
+Fingerprinting
+
+Config.Fingerprint (embed -fingerprint) is a regexp for embedded names to store with
+a hash of their contents added, /assets/css/main.css is stored as
+/assets/css/main.3f9a1c2b.css so it can be served with far future cache headers.
+References in html src and href attributes and css url() are rewritten to the new
+names and the generated AssetPath function maps the original names to the new names.
+
+	<link rel="stylesheet" href="{{ AssetPath "/assets/css/main.css" }}">
+
+Subresource Integrity
+
+Config.Integrity (embed -integrity) lists the hash algorithms, sha256, sha384 or sha512,
+used to compute the subresource integrity of every file. The value is available from
+the generated Integrity function, use it with AssetPath in templates.
+
+	<script src="{{ AssetPath "/assets/js/main.js" }}" integrity="{{ Integrity (AssetPath "/assets/js/main.js") }}"></script>
+
+FileInfo does not have an Integrity method, the FileInfo of embedded files implements
+the optional embedded.IntegrityInfo interface, assert it to read the value.
+
+	if i, ok := info.(embedded.IntegrityInfo); ok {
+	    integrity = i.Integrity()
+	}
+
+Globs
+
+Entries of Config.Files and the Path of a Source may be doublestar globs, ** matches
+any number of directories and {a,b} either alternative. The files matching are stored
+relative to the directory before the first pattern, or to the prefix marker, and
+directories without matching files are left out, directories the pattern can not
+match files in are not read. Quote globs on the command line so the shell does not
+expand them.
+
+	embed -o static/files 'web/dist/{js,css}/*' '<->docs/**'
+
+Rewriting Names
+
+Config.Rewrite (embed -rewrite pattern=replacement) is a list of regexp rules applied,
+in order, to the name of every file and directory, Config.RewriteFunc is then called
+for changes that are easier in code. Directories are rebuilt to match the new names.
+
+	config.Rewrite = []embed.Rewrite{{Pattern: `^/dist/app/(.*)$`, Replacement: "/static/$1"}}
+	config.RewriteFunc = strings.ToLower
+
+Two files stored with the same name are an error unless Config.Conflict (embed
+-conflict) is ConflictFirst, to keep the first file, or ConflictLast, to keep the last.
+A file renamed by a transformer or fingerprinting comes after the files scanned. A
+file and a directory with the same name are always an error. Two directories stored
+with the same name, such as the roots of two sources, are an error too unless
+Config.Conflict is ConflictFirst or ConflictLast, then their entries are merged.
+
+Ignore Files
+
+A .embedignore file in a source directory lists, in .gitignore syntax, entries of the
+directory and its subdirectories not to embed. Patterns may be negated with !, end
+with / to only match directories and use ** to match any number of directories. An
+ignored directory is not scanned so its files can not be included again. Set
+Config.GitIgnore (embed -gitignore) to also honor .gitignore files, .embedignore
+patterns take precedence. The .embedignore files themselves are never embedded, nor
+are .gitignore files when they are honored.
+
+	# .embedignore
+	*.map
+	drafts/
+	!important.map
+
+Directories matched by Config.Ignore, tested with a trailing separator, or by the
+ignore patterns of a Source are not scanned either. Set Config.OmitEmptyDirs (embed
+-omitemptydirs) to leave out directories that end up without any embedded files.
+
+Symbolic Links
+
+Config.Symlinks (embed -symlinks) selects how symbolic links are handled. With
+SymlinksFollow, the default, the file or directory linked to is embedded and a link
+back to a directory being scanned is an error. Sources other than the local file
+system can not tell where a link points, following more than embedded.MaxLinks
+directory links is an error instead. SymlinksSkip ignores links and SymlinksLink
+stores each link, the embedded file system opens the target in its place. Link
+targets must be inside the source and embedded.
+
+Digests
+
+Every file has a tag, the base64 hash of its contents, served as the ETag. The
+compressed representation is served with the tag followed by -gzip and a Vary:
+Accept-Encoding header so caches keep the representations apart. Config.Digest
+(embed -digest) selects the hash algorithm, sha1, sha256, sha384 or sha512, sha1 is
+the default. Call SetDigest of the optional DigestSetter interface implemented by the
+Handler from GetFileServer with DigestSHA256 or DigestSHA512 to also send Repr-Digest
+(and the older Digest) headers for the representation served.
+
+Encodings
+
+Files are compressed unless their mime type is listed in Config.NoCompressTypes
+(embed -nocompresstypes), which by default lists already compressed formats such as
+PNG, JPEG, WOFF2, zip, audio and video, or they are smaller than
+Config.CompressMinSize (embed -compressminsize). The compressed contents are only
+stored when they save at least the Config.CompressMinSavings fraction of the size
+(embed -compressminsavings), so files barely smaller compressed are not decompressed
+at runtime.
+
+Compressible files are stored gzip compressed, Config.Encoders adds other content
+codings such as br or zstd through the Encoder interface, a file is stored in each
+encoding smaller than its contents. The handler serves the smallest encoding the
+Accept-Encoding header of the request allows with the highest quality, the contents
+as is when identity has a higher quality, and the Encodings method of the
+embedded.EncodingInfo interface, implemented by the FileInfo of embedded files, lists
+the encodings of a file.
+
+	type brotliEncoder struct{}
+
+	func (brotliEncoder) Encoding() string { return "br" }
+
+	func (brotliEncoder) Encode(data []byte) ([]byte, error) {
+	    var buf bytes.Buffer
+	    w := brotli.NewWriterLevel(&buf, brotli.BestCompression)
+	    w.Write(data)
+	    err := w.Close()
+	    return buf.Bytes(), err
+	}
+
+	config.Encoders = []embed.Encoder{brotliEncoder{}}
+
+Config.Precompressed (embed -precompressed) keeps the compression of a front-end build,
+siblings named as a file with a .gz, .br or .zst extension, such as app.js.gz next to
+app.js, are stored as the gzip, br or zstd encoded contents of the file rather than as
+files of their own. A gzip sibling is checked it decompresses to the file, br and zstd
+siblings can not be checked and are left out with a warning when older than the file.
+Files with siblings are not minified as the siblings are of the contents as is. When a
+transform or command changes the contents of a file its siblings are not used, with a
+warning, and it is minified and compressed as other files. The same is true when
+fingerprinting rewrites the references of a file, but it is not minified.
+
+Minification
+
+Files with a mime type listed in Config.Minify (embed -minify) are minified, the
+built-in minifiers handle HTML, CSS, JavaScript, SVG, JSON and XML. JSON and XML are
+not listed by default, they and types using the +json or +xml suffix, such as
+application/manifest+json, are minified once added to the list. Config.HTML sets
+the HTML minifier options, such as KeepWhitespace, and Config.Minifiers adds or
+replaces the minifier of a mime type with any MinifyFunc, files of those types are
+always minified. The version of github.com/tdewolff/minify used has no option to keep
+the quotes of attribute values, register a MinifyFunc for text/html to keep them.
+
+	config.MimeTypes = map[string]string{".vue": "text/x-vue"}
+	config.Minifiers = map[string]embed.MinifyFunc{
+	    "text/x-vue": func(mimeType string, in []byte) ([]byte, error) {
+	        return vue.Minify(in)
+	    },
+	}
+
+Results of Minifiers functions are not cached in Config.CacheDir.
+
+A file that can not be minified is stored as is and a warning, with the file name
+and the position of the error, is written with the log package or passed to
+Config.Logf. Set Config.StrictMinify (embed -strictminify) to stop generation with
+a *MinifyError instead.
+
+Transforms
+
+Config.Transforms runs a Transformer on the files matching a glob pattern, after
+they are read and before they are minified and compressed, for build time
+templating, injecting headers or converting formats. It is given the embedded name,
+mime type and contents of a file and returns the new contents, optionally with a new
+name, mime type or modification time. A new modification time is ignored when
+Config.ModifyTime or Config.GitModifyTime is set and clamped to SOURCE_DATE_EPOCH, so
+builds stay reproducible. Transforms run in order, each matched against the name the
+one before gave the file.
+
+	config.Transforms = []embed.Transform{{
+	    Pattern: "docs/*.md",
+	    Transformer: embed.TransformerFunc(func(name, mimeType string, data []byte) (*embed.Transformed, error) {
+	        return &embed.Transformed{
+	            Data: markdown.ToHTML(data),
+	            Name: strings.TrimSuffix(name, ".md") + ".html",
+	        }, nil
+	    }),
+	}}
+
+Config.Commands (commands in the manifest) runs external commands the same way,
+before the Transforms, and embeds what they write to standard output. The command
+line is split at spaces and run without a shell, {in} is replaced by the path of the
+file, or of a temporary copy when an earlier command changed the contents, otherwise
+the file is written to standard input, and {name} by the embedded name. Ext replaces the extension of the name, Timeout limits how long the command
+may run, one minute by default, and Cache keeps the output in Config.CacheDir.
+
+	"commands": [
+	    {"pattern": "*.scss", "command": "sassc {in}", "ext": ".css", "timeout": "30s"}
+	]
+
+A command that exits with a non-zero status or times out stops generation with its
+standard error.
+
+Mime Types
+
+Mime types come from a built-in table of common web extensions, such as .html, .js,
+.mjs, .wasm and .webmanifest, rather than the system mime database so the output is
+the same on every machine. Config.MimeTypes (mimeTypes in the manifest) adds or
+overrides extensions, files with other extensions have the mime type detected from
+their contents. Text, JavaScript, JSON and XML types get a charset=utf-8 parameter
+unless one is given.
+
+Reproducible Output
+
+Modification times are stored for every file and directory. For output that is
+identical across machines and checkouts either set Config.ModifyTime (embed
+-modifytime) to a Unix timestamp or RFC 3339 time, set GitModifyTime (embed -gittime)
+to use the last commit time of each file or set the SOURCE_DATE_EPOCH environment
+variable, modification times later than it are clamped to it.
 
 Example
 
@@ -0,0 +1,66 @@
+package embedded
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"hash"
+	"io"
+)
+
+const (
+	// DigestSHA256 SHA-256 digest algorithm for Repr-Digest headers
+	DigestSHA256 = "sha-256"
+	// DigestSHA512 SHA-512 digest algorithm for Repr-Digest headers
+	DigestSHA512 = "sha-512"
+)
+
+var digestHashes = map[string]func() hash.Hash{
+	DigestSHA256: sha256.New,
+	DigestSHA512: sha512.New,
+}
+
+type digestKey struct {
+	algorithm string
+	encoding  string
+}
+
+// digest returns the base64 digest of the file contents using algorithm, if encoding
+// is set the digest is of the contents in that content coding. Digests are computed
+// on first use.
+func (f *file) digest(algorithm string, encoding string) (value string) {
+	key := digestKey{algorithm: algorithm, encoding: encoding}
+
+	f.digestLock.Lock()
+	defer f.digestLock.Unlock()
+
+	if v, ok := f.digests[key]; ok {
+		return v
+	}
+
+	if newHash := digestHashes[algorithm]; newHash != nil {
+		h := newHash()
+
+		if len(encoding) > 0 {
+			h.Write(f.encoded(encoding))
+		} else if f.compressed {
+			if ungzip, err := gzip.NewReader(bytes.NewReader(f.data)); err == nil {
+				io.Copy(h, ungzip)
+				ungzip.Close()
+			}
+		} else {
+			h.Write(f.data)
+		}
+
+		value = base64.StdEncoding.EncodeToString(h.Sum(nil))
+
+		if f.digests == nil {
+			f.digests = make(map[digestKey]string)
+		}
+		f.digests[key] = value
+	}
+
+	return
+}
@@ -12,6 +12,22 @@ Add a file to embedded filesystem, the file must not exist.
 	AddFolder(path string, name string, local string, modtime int64, paths ...string) error
 Add an directory to the embedded filesystem, the folder must not exist.
 
+	AddEncoding(path string, encoding string, data []byte) error
+Add the contents of a file compressed with another content coding, such as br or zstd,
+served to clients that accept it. The file must exist and not have the encoding. It is
+part of the optional EncodingAdder interface so other FileSystem implementations need
+not provide it.
+
+	AddLink(path string, name string, target string, modtime int64) error
+Add a symbolic link, target is the embedded path opened in place of the link, at most
+MaxLinks links are followed resolving a name. It is part of the optional LinkAdder
+interface so other FileSystem implementations need not provide it.
+
+	SetIntegrity(path string, integrity string) error
+Set the subresource integrity of a file, a space separated list of hashes. It is part of
+the optional IntegritySetter interface so other FileSystem implementations need not
+provide it, the FileInfo of the file implements IntegrityInfo.
+
 	http.FileSystem
 		Open(name string) (http.File, error)
 Implement a http.FileSystem Open method returning a http.File.
@@ -37,6 +53,14 @@ Use on disk copy instead of embedded data intended for development.
 When developing javascript or html you want the origion source served (uncompressed and not minified)
 this will only work with file that have a local path specified. Open will return a os.File using the local path.
 
+	IOFS() fs.FS
+Returns the file system as an io/fs.FS, the value returned also implements fs.StatFS, fs.ReadFileFS,
+fs.ReadDirFS, fs.GlobFS and fs.SubFS so it can be used with fs.WalkDir, template.ParseFS, http.FS and
+testing/fstest. Names follow the io/fs conventions, they are unrooted so /css/main.css is css/main.css.
+Files opened implement fs.ReadDirFile and the FileInfo values implement fs.DirEntry. It is part of
+the optional IOFSProvider interface, the package function IOFS(FileSystem) uses it when implemented
+and otherwise opens files through http.FileSystem.
+
 
 Handler
 
@@ -44,8 +68,10 @@ Implement a handler the serve data in response to http requests.
 
 	http.Handler
 		ServeHTTP(http.ResponseWriter, *http.Request)
-Serves data in response to a http request, this will serve gzip files to clients that accept compressed content.
-also serve Etag-based conditional requests.
+Serves data in response to a http request, this will serve the smallest encoding of a file,
+gzip or one added with AddEncoding, the Accept-Encoding header of the request allows
+with the highest quality, x-gzip is taken as gzip. The contents are served as is when identity,
+1 unless given, has a higher quality. also serve Etag-based conditional requests.
 
 
 	SetNotFoundHandler(http.Handler)
@@ -59,6 +85,11 @@ Set a hander to be called for no permission http.StatusForbidden
 If true and the folder does not contain index.html render folder
 otherwise the PermissionHandler will be called usually serving 403 http.StatusForbidden
 
+	SetDigest(algorithm string)
+Send Repr-Digest and Digest headers using DigestSHA256 or DigestSHA512, an empty algorithm
+disables them. It is part of the optional DigestSetter interface so other Handler
+implementations need not provide it.
+
 FileInfo
 
 Internal file info and access file contents
@@ -69,12 +100,24 @@ Implements the os.FileInfo methods.
 	Compressed() bool
 Is this file compressed
 
+	Encodings() []string
+Content codings the file is stored in, smallest first, gzip is included if compressed.
+It is part of the optional EncodingInfo interface.
+
 	Tag() string
 Etag for the file contents, used to conditionally serve the content based on If-None-Match header.
 
 	MimeType() string
 Mimetype for file contents when this file is served the mime type header will be filled in with this value.
 
+	Integrity() string
+Subresource integrity of the file contents, empty if none was set. It is part of the optional
+IntegrityInfo interface, assert it on the FileInfo.
+
+	if i, ok := info.(embedded.IntegrityInfo); ok {
+		integrity = i.Integrity()
+	}
+
 	String() string
 file contents as string, if not compress this will just be the internal string,
 if it is compress it will uncompress it.
@@ -5,6 +5,7 @@ import (
 	"compress/gzip"
 	"errors"
 	"io"
+	"io/fs"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 	"unsafe"
 )
@@ -76,6 +78,50 @@ type FileInfo interface {
 	Raw() []byte      // raw bytes this is in readonly memory
 }
 
+// IOFSProvider is implemented by a FileSystem that provides an io/fs.FS, such
+// as the one returned by New
+type IOFSProvider interface {
+	// IOFS returns the file system as an io/fs.FS, the value returned also
+	// implements fs.StatFS, fs.ReadFileFS, fs.ReadDirFS, fs.GlobFS and fs.SubFS.
+	IOFS() fs.FS
+}
+
+// LinkAdder is implemented by a FileSystem that stores symbolic links,
+// such as the one returned by New
+type LinkAdder interface {
+	// AddLink add a symbolic link to embedded filesystem, target is the
+	// embedded path opened in place of the link
+	AddLink(path string, name string, target string, modtime int64) error
+}
+
+// EncodingAdder is implemented by a FileSystem that stores files in other
+// content codings, such as the one returned by New
+type EncodingAdder interface {
+	// AddEncoding adds the contents of a file compressed with the content coding
+	// encoding (for example br), served to clients that accept it
+	AddEncoding(path string, encoding string, data []byte) error
+}
+
+// EncodingInfo is implemented by a FileInfo of a file stored in content codings
+type EncodingInfo interface {
+	Encodings() []string // Content codings the file is stored in, smallest first
+}
+
+// IntegritySetter is implemented by a FileSystem that stores the subresource
+// integrity of files, such as the one returned by New
+type IntegritySetter interface {
+	// SetIntegrity sets the subresource integrity of a file, a space separated
+	// list of hashes such as sha384-<base64 digest>
+	SetIntegrity(path string, integrity string) error
+}
+
+// IntegrityInfo is implemented by a FileInfo of a file with a subresource integrity,
+// such as the FileInfo of the file system returned by New. Assert it on the FileInfo,
+// or the os.FileInfo from Stat of an opened file, to read the integrity.
+type IntegrityInfo interface {
+	Integrity() string // Subresource integrity of the file contents
+}
+
 type file struct {
 	name       string
 	size       int64
@@ -85,11 +131,30 @@ type file struct {
 	compressed bool
 	mimeType   string
 	tag        string
+	integrity  string
+	link       string
 	data       []byte
+	encodings  []encoding
 	str        string
 	subFiles   []FileInfo
+	digestLock sync.Mutex
+	digests    map[digestKey]string
 }
 
+// encoding the contents of a file compressed with a content coding
+type encoding struct {
+	name string
+	data []byte
+}
+
+// gzipEncoding the content coding of compressed files
+const gzipEncoding = "gzip"
+
+// MaxLinks is the number of links followed resolving a name before giving up
+const MaxLinks = 40
+
+var errTooManyLinks = errors.New("too many links")
+
 type files struct {
 	list      map[string]*file
 	local     bool
@@ -103,18 +168,50 @@ func New(count int) FileSystem {
 
 func (fs *files) Open(name string) (file http.File, err error) {
 	name = path.Clean("/" + name)
-	if f, ok := fs.list[name]; ok {
-		if fs.local && len(f.local) > 0 {
-			file, err = os.Open(f.local)
-		} else {
-			file = &reader{file: f, length: f.size}
-		}
+
+	if f, e := fs.lookup(name); e != nil {
+		err = e
+	} else if fs.local && len(f.local) > 0 {
+		file, err = os.Open(f.local)
 	} else {
-		err = os.ErrNotExist
+		r := &reader{file: f, length: f.size}
+		if fs.list[name] != f {
+			// Opened through a link, keep the name opened
+			r.name = path.Base(name)
+		}
+		file = r
 	}
 	return
 }
 
+// lookup returns the entry for name following links, including links to
+// directories in the name
+func (fs *files) lookup(name string) (*file, error) {
+	for links := 0; links <= MaxLinks; links++ {
+		f, ok := fs.list[name]
+
+		switch {
+		case ok && len(f.link) == 0:
+			return f, nil
+		case ok:
+			name = f.link
+		default:
+			dir := path.Dir(name)
+			for f, ok = fs.list[dir]; !ok && dir != "/"; f, ok = fs.list[dir] {
+				dir = path.Dir(dir)
+			}
+
+			if !ok || len(f.link) == 0 {
+				return nil, os.ErrNotExist
+			}
+
+			name = path.Join(f.link, strings.TrimPrefix(name, dir))
+		}
+	}
+
+	return nil, errTooManyLinks
+}
+
 // walk recursively descends path, calling walkFn.
 func (fs *files) walk(fpath string, info FileInfo, walkFn WalkFunc) (err error) {
 	if !info.IsDir() {
@@ -174,6 +271,17 @@ func (fs *files) Copy(target string, mode os.FileMode) error {
 			return os.MkdirAll(targetPath, dirmode)
 		}
 
+		if link := info.(*file).link; len(link) > 0 {
+			rel, err := filepath.Rel(filepath.Dir(filepath.FromSlash(path)), filepath.FromSlash(link))
+			if err == nil {
+				err = os.MkdirAll(filepath.Dir(targetPath), dirmode)
+			}
+			if err == nil {
+				err = os.Symlink(rel, targetPath)
+			}
+			return err
+		}
+
 		file, err := fs.Open(path)
 		if err == nil {
 			defer file.Close()
@@ -209,7 +317,7 @@ func (fs *files) AddFile(path string, name string, local string, size int64,
 		return os.ErrExist
 	}
 
-	fs.list[path] = &file{
+	f := &file{
 		name:       name,
 		local:      local,
 		size:       size,
@@ -221,6 +329,12 @@ func (fs *files) AddFile(path string, name string, local string, size int64,
 		str:        str,
 	}
 
+	if compressed {
+		f.addEncoding(gzipEncoding, data)
+	}
+
+	fs.list[path] = f
+
 	if fs.addFolder {
 		// Now add folder entries as required
 		if err = fs.addToFolder(path); err != nil {
@@ -256,14 +370,66 @@ func (fs *files) AddFolder(path string, name string, local string, modtime int64
 	return nil
 }
 
+// AddLink Adds a symbolic link to the file system, if entry already exists return an error
+func (fs *files) AddLink(path string, name string, target string, modtime int64) (err error) {
+	if _, ok := fs.list[path]; ok {
+		return os.ErrExist
+	}
+
+	fs.list[path] = &file{
+		name:    name,
+		modtime: modtime,
+		link:    target,
+	}
+
+	if fs.addFolder {
+		// Now add folder entries as required
+		if err = fs.addToFolder(path); err != nil {
+			delete(fs.list, path)
+		}
+	}
+
+	return
+}
+
+// SetIntegrity sets the subresource integrity of a file
+func (fs *files) SetIntegrity(path string, integrity string) (err error) {
+	if f, ok := fs.list[path]; !ok {
+		err = os.ErrNotExist
+	} else if f.isDir {
+		err = &os.PathError{Op: "setintegrity", Path: path, Err: os.ErrInvalid}
+	} else {
+		f.integrity = integrity
+	}
+
+	return
+}
+
+// AddEncoding adds the contents of a file compressed with the content coding encoding,
+// if the file already has the encoding return an error
+func (fs *files) AddEncoding(path string, encoding string, data []byte) (err error) {
+	if f, ok := fs.list[path]; !ok {
+		err = os.ErrNotExist
+	} else if f.isDir || len(f.link) > 0 {
+		err = &os.PathError{Op: "addencoding", Path: path, Err: os.ErrInvalid}
+	} else if f.encoded(encoding) != nil {
+		err = &os.PathError{Op: "addencoding", Path: path, Err: os.ErrExist}
+	} else {
+		f.addEncoding(encoding, data)
+	}
+
+	return
+}
+
 func (fs *files) addToFolder(filename string) (err error) {
 	folder := path.Dir(filename)
 
 	if f, ok := fs.list[folder]; !ok {
+		// Use the time of the entry so the folder does not depend on when it was created
 		fs.list[folder] = &file{
 			name:    path.Base(folder),
 			isDir:   true,
-			modtime: time.Now().Unix(),
+			modtime: fs.list[filename].modtime,
 		}
 		err = fs.addToFolder(folder)
 		if err != nil {
@@ -299,11 +465,17 @@ func (fs *files) WriteFile(filename string, data []byte, perm os.FileMode) (err
 	// If file exists just replace the data
 	if f, ok := fs.list[filename]; ok {
 		f.tag = ""
+		f.integrity = ""
+		f.link = ""
 		f.mimeType = ""
 		f.size = int64(len(local))
 		f.compressed = false
+		f.encodings = nil
 		f.data = local
 		f.str = localStr
+		f.digestLock.Lock()
+		f.digests = nil
+		f.digestLock.Unlock()
 	} else {
 		fs.list[filename] = &file{
 			name:    path.Base(filename),
@@ -326,6 +498,11 @@ func (fs *files) UseLocal(value bool) {
 	fs.local = value
 }
 
+// IOFS returns the file system as an io/fs.FS
+func (fs *files) IOFS() fs.FS {
+	return &ioFS{files: fs, root: "/"}
+}
+
 // Name name of the file
 func (f *file) Name() string {
 	return f.name
@@ -341,6 +518,9 @@ func (f *file) Mode() os.FileMode {
 	if f.isDir {
 		return os.ModePerm | os.ModeDir
 	}
+	if len(f.link) > 0 {
+		return os.ModePerm | os.ModeSymlink
+	}
 	return os.ModePerm
 }
 
@@ -354,6 +534,16 @@ func (f *file) IsDir() bool {
 	return f.isDir
 }
 
+// Type type bits of the file mode
+func (f *file) Type() fs.FileMode {
+	return f.Mode().Type()
+}
+
+// Info returns the FileInfo for the file
+func (f *file) Info() (fs.FileInfo, error) {
+	return f, nil
+}
+
 // Sys underlying data source (can return nil)
 func (f *file) Sys() interface{} {
 	return f
@@ -363,6 +553,37 @@ func (f *file) Compressed() bool {
 	return f.compressed
 }
 
+// Encodings returns the content codings the file is stored in, smallest first
+func (f *file) Encodings() (list []string) {
+	for _, e := range f.encodings {
+		list = append(list, e.name)
+	}
+	return
+}
+
+// encoded returns the contents compressed with the content coding, nil if the
+// file is not stored in it
+func (f *file) encoded(name string) []byte {
+	for _, e := range f.encodings {
+		if e.name == name {
+			return e.data
+		}
+	}
+	return nil
+}
+
+// addEncoding adds the contents compressed with the content coding keeping the
+// encodings smallest first
+func (f *file) addEncoding(name string, data []byte) {
+	i := sort.Search(len(f.encodings), func(i int) bool {
+		return len(f.encodings[i].data) > len(data)
+	})
+
+	f.encodings = append(f.encodings, encoding{})
+	copy(f.encodings[i+1:], f.encodings[i:])
+	f.encodings[i] = encoding{name: name, data: data}
+}
+
 func (f *file) Tag() string {
 	return f.tag
 }
@@ -371,6 +592,10 @@ func (f *file) MimeType() string {
 	return f.mimeType
 }
 
+func (f *file) Integrity() string {
+	return f.integrity
+}
+
 // String returns (uncompressed, if necessary) content of file as a string
 func (f *file) String() (str string) {
 	str = f.str
@@ -406,13 +631,25 @@ func (f *file) Raw() []byte {
 
 type reader struct {
 	*file
-	closed         bool
-	position       int64
-	seek           int64
-	length         int64
-	readCompressed bool
-	decompressor   *gzip.Reader
-	byteReader     *bytes.Reader
+	closed       bool
+	position     int64
+	seek         int64
+	length       int64
+	encoded      []byte
+	decompressor *gzip.Reader
+	byteReader   *bytes.Reader
+	// digestAlgorithm set by the server to add digest headers
+	digestAlgorithm string
+	// name the file was opened as when opened through a link
+	name string
+}
+
+// Name name of the file
+func (r *reader) Name() string {
+	if len(r.name) > 0 {
+		return r.name
+	}
+	return r.file.Name()
 }
 
 func (r *reader) Close() (err error) {
@@ -439,6 +676,30 @@ func (r *reader) Stat() (info os.FileInfo, err error) {
 }
 
 func (r *reader) Readdir(count int) (list []os.FileInfo, err error) {
+	var entries []FileInfo
+
+	if entries, err = r.entries(count, "reader.Readdir"); err == nil {
+		list = make([]os.FileInfo, len(entries))
+		for i, e := range entries {
+			list[i] = e
+		}
+	}
+	return
+}
+
+func (r *reader) ReadDir(count int) (list []fs.DirEntry, err error) {
+	var entries []FileInfo
+
+	if entries, err = r.entries(count, "reader.ReadDir"); err == nil {
+		list = make([]fs.DirEntry, len(entries))
+		for i, e := range entries {
+			list[i] = e.(*file)
+		}
+	}
+	return
+}
+
+func (r *reader) entries(count int, op string) (entries []FileInfo, err error) {
 	if r.closed {
 		err = os.ErrInvalid
 	} else {
@@ -450,15 +711,11 @@ func (r *reader) Readdir(count int) (list []os.FileInfo, err error) {
 				if count <= 0 || int64(count) > l-r.position {
 					count = int(l - r.position)
 				}
-				entries := r.subFiles[r.position : r.position+int64(count)]
+				entries = r.subFiles[r.position : r.position+int64(count)]
 				r.position += int64(count)
-				list = make([]os.FileInfo, len(entries))
-				for i, e := range entries {
-					list[i] = e
-				}
 			}
 		} else {
-			err = errors.New("reader.Readdir: not valid on file")
+			err = errors.New(op + ": not valid on file")
 		}
 	}
 	return
@@ -503,7 +760,13 @@ func (r *reader) Read(b []byte) (n int, err error) {
 		if r.isDir {
 			err = errors.New("fileRead.Read: not valid on directory")
 		} else {
-			if r.compressed && !r.readCompressed {
+			if r.encoded != nil {
+				if r.seek >= r.length {
+					return 0, io.EOF
+				}
+				n = copy(b, r.encoded[r.seek:])
+				r.seek += int64(n)
+			} else if r.compressed {
 				// Setup to decompress data
 				if r.decompressor == nil {
 					r.byteReader = bytes.NewReader(r.data)
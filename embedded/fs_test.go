@@ -6,6 +6,7 @@ import (
 	"crypto/sha1"
 	"encoding/base64"
 	"io"
+	"io/fs"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -105,6 +106,64 @@ func TestWalk(t *testing.T) {
 
 }
 
+func TestAddEncoding(t *testing.T) {
+	dir, f := makeFs()
+	defer os.RemoveAll(dir)
+
+	f.(LinkAdder).AddLink("/link.html", "link.html", "/index.html", setTime)
+
+	small := []byte("br")
+
+	for _, test := range []struct {
+		name     string
+		file     string
+		encoding string
+		data     []byte
+		expect   []string
+		hasError bool
+	}{
+		{"Smallest", "/index.html", "br", small, []string{"br", "gzip"}, false},
+		{"Largest", "/index.html", "zstd", append(indexCompressed, 0), []string{"br", "gzip", "zstd"}, false},
+		{"Uncompressed", "/settings.html", "br", small, []string{"br"}, false},
+		{"Duplicate", "/index.html", "gzip", small, nil, true},
+		{"Missing", "/missing.html", "br", small, nil, true},
+		{"Folder", "/", "br", small, nil, true},
+		{"Link", "/link.html", "br", small, nil, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := f.(EncodingAdder).AddEncoding(test.file, test.encoding, test.data)
+
+			if err == nil {
+				if test.hasError {
+					t.Errorf("Did no get error as expected when adding %s", test.file)
+				}
+			} else if !test.hasError {
+				t.Errorf("Got unexpected error adding %s %v", test.file, err)
+			}
+
+			if !test.hasError {
+				if file, err := f.Open(test.file); err != nil {
+					t.Errorf("Open returned unexpected error %v", err)
+				} else {
+					info, _ := file.Stat()
+					if list := info.(EncodingInfo).Encodings(); !reflect.DeepEqual(list, test.expect) {
+						t.Errorf("Encodings did not return valid value got (%v) expected (%v)", list, test.expect)
+					}
+				}
+			}
+		})
+	}
+
+	f.WriteFile("/index.html", []byte("replaced"), os.ModePerm)
+
+	if file, err := f.Open("/index.html"); err == nil {
+		info, _ := file.Stat()
+		if list := info.(EncodingInfo).Encodings(); list != nil {
+			t.Errorf("WriteFile did not clear encodings got (%v)", list)
+		}
+	}
+}
+
 func TestAdd(t *testing.T) {
 
 	dir, f := makeFs()
@@ -175,6 +234,141 @@ func TestAdd(t *testing.T) {
 	}
 }
 
+func TestAddFolderTime(t *testing.T) {
+	f := New(1)
+	f.AddFolder("/", "/", "", setTime)
+
+	if err := f.AddFile("/scripts/lib/utils.js", "utils.js", "", 0, setTime-10, "", "", false, nil, ""); err != nil {
+		t.Fatalf("Got unexpected error adding file %v", err)
+	}
+
+	for _, name := range []string{"/scripts", "/scripts/lib"} {
+		t.Run(name, func(t *testing.T) {
+			if info, err := fs.Stat(IOFS(f), name[1:]); err != nil {
+				t.Errorf("Stat returned unexpected error %v", err)
+			} else if m := info.ModTime().Unix(); m != setTime-10 {
+				t.Errorf("ModTime did not return valid value got (%v) expected %v", m, setTime-10)
+			}
+		})
+	}
+}
+
+func TestSetIntegrity(t *testing.T) {
+	dir, f := makeFs()
+	defer os.RemoveAll(dir)
+
+	const integrity = "sha384-oqVuAfXRKap7fdgcCY5uykM6+R9GqQ8K/uxy9rx7HNQlGYl1kPzQho1wx4JwY8wC"
+
+	for _, test := range []struct {
+		name     string
+		file     string
+		hasError bool
+	}{
+		{"Set", "/index.html", false},
+		{"Missing", "/missing.html", true},
+		{"Folder", "/", true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := f.(IntegritySetter).SetIntegrity(test.file, integrity)
+
+			if err == nil {
+				if test.hasError {
+					t.Errorf("Did no get error as expected when setting %s", test.file)
+				}
+			} else if !test.hasError {
+				t.Errorf("Got unexpected error setting %s %v", test.file, err)
+			}
+
+			if !test.hasError {
+				if file, err := f.Open(test.file); err != nil {
+					t.Errorf("Open returned unexpected error %v", err)
+				} else {
+					info, _ := file.Stat()
+					if i := info.(IntegrityInfo).Integrity(); i != integrity {
+						t.Errorf("Integrity did not return valid value got (%s) expected (%s)", i, integrity)
+					}
+				}
+			}
+		})
+	}
+
+	f.WriteFile("/index.html", []byte("replaced"), os.ModePerm)
+
+	if file, err := f.Open("/index.html"); err == nil {
+		info, _ := file.Stat()
+		if i := info.(IntegrityInfo).Integrity(); i != "" {
+			t.Errorf("WriteFile did not clear integrity got (%s)", i)
+		}
+	}
+}
+
+func TestAddLink(t *testing.T) {
+	f := New(8).(interface {
+		FileSystem
+		LinkAdder
+	})
+
+	f.AddFile("/files/index.html", "index.html", "", indexSize, setTime, mimeType, indexTag, false, indexBytes, index)
+	f.AddLink("/home.html", "home.html", "/files/index.html", setTime)
+	f.AddLink("/start.html", "start.html", "/home.html", setTime)
+	f.AddLink("/site", "site", "/files", setTime)
+	f.AddLink("/loop", "loop", "/loop", setTime)
+	f.AddLink("/missing", "missing", "/files/missing.html", setTime)
+	f.AddFolder("/files", "files", "", setTime, "/files/index.html")
+	f.AddFolder("/", "/", "", setTime, "/files", "/home.html", "/loop", "/missing", "/site", "/start.html")
+
+	if err := f.AddLink("/site", "site", "/files", setTime); err == nil {
+		t.Errorf("AddLink did not return error adding existing entry")
+	}
+
+	for _, test := range []struct {
+		name     string
+		file     string
+		isDir    bool
+		hasError bool
+	}{
+		{"File", "/home.html", false, false},
+		{"Chain", "/start.html", false, false},
+		{"Folder", "/site", true, false},
+		{"In Folder", "/site/index.html", false, false},
+		{"Loop", "/loop", false, true},
+		{"Dangling", "/missing", false, true},
+		{"Missing In Folder", "/site/missing.html", false, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			file, err := f.Open(test.file)
+
+			if err == nil {
+				if test.hasError {
+					t.Errorf("Did no get error as expected opening %s", test.file)
+				} else if test.isDir {
+					testStat(t, test.file, file, true, false)
+				} else if b, err := ioutil.ReadAll(file); err != nil || !reflect.DeepEqual(b, indexBytes) {
+					t.Errorf("Returned data did not match get (%s) expectd(%s) error %v", b, indexBytes, err)
+				}
+			} else if !test.hasError {
+				t.Errorf("Got unexpected error opening %s %v", test.file, err)
+			}
+		})
+	}
+
+	f.Walk("/", func(path string, info FileInfo, err error) error {
+		if path == "/home.html" && info.Mode()&os.ModeSymlink == 0 {
+			t.Errorf("Link %s mode is %v", path, info.Mode())
+		}
+		return nil
+	})
+
+	dir, _ := ioutil.TempDir("", "link-test")
+	defer os.RemoveAll(dir)
+
+	f.Copy(dir, os.ModePerm)
+
+	if b, err := ioutil.ReadFile(filepath.Join(dir, "site", "index.html")); err != nil || !reflect.DeepEqual(b, indexBytes) {
+		t.Errorf("Copy did not create link got (%s) error %v", b, err)
+	}
+}
+
 func TestFiles(t *testing.T) {
 
 	dir, f := makeFs()
@@ -549,5 +743,5 @@ func compress(data []byte) []byte {
 
 func tag(data []byte) string {
 	hash := sha1.Sum(data)
-	return base64.RawURLEncoding.EncodeToString(hash[:]) + "-gz"
+	return base64.RawURLEncoding.EncodeToString(hash[:])
 }
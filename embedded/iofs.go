@@ -0,0 +1,213 @@
+package embedded
+
+import (
+	"io/fs"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// IOFS returns fsys as an io/fs.FS, using its IOFS method when it implements
+// IOFSProvider. Other file systems are opened through http.FileSystem and only
+// implement fs.FS, the files opened implement fs.ReadDirFile.
+func IOFS(fsys FileSystem) fs.FS {
+	if p, ok := fsys.(IOFSProvider); ok {
+		return p.IOFS()
+	}
+	return &httpFS{fsys: fsys}
+}
+
+// httpFS presents a http.FileSystem as an io/fs file system
+type httpFS struct {
+	fsys http.FileSystem
+}
+
+// Open opens the named file.
+func (s *httpFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	file, err := s.fsys.Open("/" + name)
+	if err != nil {
+		if e, ok := err.(*fs.PathError); ok {
+			err = e.Err
+		}
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	return &httpFile{File: file}, nil
+}
+
+// httpFile adds fs.ReadDirFile to a http.File
+type httpFile struct {
+	http.File
+}
+
+// ReadDir reads the contents of the directory.
+func (f *httpFile) ReadDir(count int) (list []fs.DirEntry, err error) {
+	var infos []os.FileInfo
+
+	if infos, err = f.Readdir(count); err == nil {
+		list = make([]fs.DirEntry, len(infos))
+		for i, info := range infos {
+			list[i] = dirEntry{info}
+		}
+	}
+
+	return
+}
+
+// dirEntry presents a fs.FileInfo as a fs.DirEntry
+type dirEntry struct {
+	fs.FileInfo
+}
+
+// Type type bits of the file mode
+func (d dirEntry) Type() fs.FileMode {
+	return d.Mode().Type()
+}
+
+// Info returns the FileInfo for the file
+func (d dirEntry) Info() (fs.FileInfo, error) {
+	return d.FileInfo, nil
+}
+
+// ioFS presents the embedded files as an io/fs file system rooted at root
+type ioFS struct {
+	files *files
+	root  string
+}
+
+// fullName convert a io/fs path name to the embedded name
+func (s *ioFS) fullName(op string, name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	return path.Join(s.root, name), nil
+}
+
+// Open opens the named file.
+func (s *ioFS) Open(name string) (fs.File, error) {
+	full, err := s.fullName("open", name)
+
+	if err == nil {
+		var file fs.File
+
+		if file, err = s.files.Open(full); err == nil {
+			return file, nil
+		}
+
+		if e, ok := err.(*fs.PathError); ok {
+			err = e.Err
+		}
+		err = &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	return nil, err
+}
+
+// Stat returns a FileInfo describing the named file.
+func (s *ioFS) Stat(name string) (info fs.FileInfo, err error) {
+	var file fs.File
+
+	if file, err = s.Open(name); err == nil {
+		defer file.Close()
+		info, err = file.Stat()
+	}
+
+	return
+}
+
+// ReadFile reads the named file and returns its contents.
+func (s *ioFS) ReadFile(name string) (b []byte, err error) {
+	var (
+		file fs.File
+		info fs.FileInfo
+	)
+
+	if file, err = s.Open(name); err == nil {
+		defer file.Close()
+		info, err = file.Stat()
+	}
+
+	if err == nil {
+		if info.IsDir() {
+			err = &fs.PathError{Op: "read", Path: name, Err: fs.ErrInvalid}
+		} else {
+			b, err = ioutil.ReadAll(file)
+		}
+	}
+
+	return
+}
+
+// ReadDir reads the named directory and returns a list of directory entries sorted by filename.
+func (s *ioFS) ReadDir(name string) (list []fs.DirEntry, err error) {
+	var file fs.File
+
+	if file, err = s.Open(name); err == nil {
+		defer file.Close()
+
+		if dir, ok := file.(fs.ReadDirFile); ok {
+			list, err = dir.ReadDir(-1)
+		} else {
+			err = &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+		}
+	}
+
+	if err == nil {
+		sort.Slice(list, func(i, j int) bool { return list[i].Name() < list[j].Name() })
+	}
+
+	return
+}
+
+// Glob returns the names of all files matching pattern.
+func (s *ioFS) Glob(pattern string) (matches []string, err error) {
+	// Check pattern is well-formed.
+	if _, err = path.Match(pattern, ""); err != nil {
+		return
+	}
+
+	for name := range s.files.list {
+		var rel string
+
+		switch {
+		case name == s.root:
+			rel = "."
+		case s.root == "/":
+			rel = name[1:]
+		case strings.HasPrefix(name, s.root+"/"):
+			rel = name[len(s.root)+1:]
+		default:
+			continue
+		}
+
+		if ok, _ := path.Match(pattern, rel); ok {
+			matches = append(matches, rel)
+		}
+	}
+
+	sort.Strings(matches)
+
+	return
+}
+
+// Sub returns an FS corresponding to the subtree rooted at dir.
+func (s *ioFS) Sub(dir string) (fs.FS, error) {
+	full, err := s.fullName("sub", dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if dir == "." {
+		return s, nil
+	}
+
+	return &ioFS{files: s.files, root: full}, nil
+}
@@ -0,0 +1,155 @@
+package embedded
+
+import (
+	"errors"
+	"io/fs"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestIOFS(t *testing.T) {
+	fsys := IOFS(makeIOFs())
+
+	t.Run("TestFS", func(t *testing.T) {
+		if err := fstest.TestFS(fsys, "index.html", "settings.html", "files/js/index.html"); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("WalkDir", func(t *testing.T) {
+		var list []string
+
+		err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+			list = append(list, path)
+			return err
+		})
+
+		expect := []string{".", "files", "files/js", "files/js/index.html", "index.html", "settings.html"}
+
+		if err != nil {
+			t.Errorf("WalkDir returned unexpected error %v", err)
+		}
+
+		if !reflect.DeepEqual(list, expect) {
+			t.Errorf("Did not WalkDir as expected got(%v) expect (%v)", list, expect)
+		}
+	})
+
+	for _, test := range []struct {
+		name     string
+		file     string
+		hasError bool
+		expect   []byte
+	}{
+		{"ReadFile", "index.html", false, indexBytes},
+		{"ReadFile Sub", "files/js/index.html", false, indexBytes},
+		{"ReadFile Folder", "files", true, nil},
+		{"ReadFile Missing", "missing.html", true, nil},
+		{"ReadFile Invalid", "/index.html", true, nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := fs.ReadFile(fsys, test.file)
+
+			if err == nil {
+				if test.hasError {
+					t.Errorf("ReadFile %s did not error as expected", test.file)
+				}
+			} else {
+				if !test.hasError {
+					t.Errorf("ReadFile %s returned unexpected error %v", test.file, err)
+				}
+			}
+
+			if !reflect.DeepEqual(b, test.expect) {
+				t.Errorf("Did not get expected contents (%s) expect (%s)", b, test.expect)
+			}
+		})
+	}
+
+	for _, test := range []struct {
+		name    string
+		pattern string
+		expect  []string
+	}{
+		{"Glob", "*.html", []string{"index.html", "settings.html"}},
+		{"Glob Nested", "files/*/*.html", []string{"files/js/index.html"}},
+		{"Glob None", "*.css", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if list, err := fs.Glob(fsys, test.pattern); err != nil {
+				t.Errorf("Glob %s returned unexpected error %v", test.pattern, err)
+			} else if !reflect.DeepEqual(list, test.expect) {
+				t.Errorf("Did not Glob as expected got(%v) expect (%v)", list, test.expect)
+			}
+		})
+	}
+
+	t.Run("Glob Bad", func(t *testing.T) {
+		if _, err := fs.Glob(fsys, "[]"); err == nil {
+			t.Errorf("Glob did not error on bad pattern")
+		}
+	})
+
+	t.Run("Sub", func(t *testing.T) {
+		if sub, err := fs.Sub(fsys, "files"); err != nil {
+			t.Errorf("Sub returned unexpected error %v", err)
+		} else if err = fstest.TestFS(sub, "js/index.html"); err != nil {
+			t.Error(err)
+		}
+
+		if _, err := fs.Sub(fsys, "../files"); err == nil {
+			t.Errorf("Sub did not error on invalid path")
+		}
+	})
+}
+
+func TestIOFSWrapped(t *testing.T) {
+	// Only the FileSystem methods of the wrapped value are visible
+	fsys := IOFS(struct{ FileSystem }{makeIOFs()})
+
+	if _, ok := fsys.(*httpFS); !ok {
+		t.Fatalf("IOFS did not wrap a FileSystem without IOFS got (%T)", fsys)
+	}
+
+	if err := fstest.TestFS(fsys, "index.html", "settings.html", "files/js/index.html"); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := fsys.Open("/index.html"); err == nil {
+		t.Errorf("Open did not error on invalid path")
+	}
+
+	if _, err := fsys.Open("missing.html"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Open did not return not exist got (%v)", err)
+	}
+}
+
+func makeIOFs() FileSystem {
+	f := New(6)
+
+	f.AddFile("/index.html", "index.html", "",
+		indexSize, setTime, mimeType, indexTag, true, indexCompressed, string(indexCompressed))
+
+	f.AddFile("/settings.html", "settings.html", "",
+		indexSize, setTime, mimeType, indexTag, false, indexBytes, index)
+
+	f.AddFile("/files/js/index.html", "index.html", "",
+		indexSize, setTime, mimeType, indexTag, true, indexCompressed, string(indexCompressed))
+
+	f.AddFolder("/files/js", "js", "", setTime,
+		"/files/js/index.html",
+	)
+
+	f.AddFolder("/files", "files", "", setTime,
+		"/files/js",
+	)
+
+	f.AddFolder("/", "/", "", setTime,
+		"/files",
+		"/index.html",
+		"/settings.html",
+	)
+
+	return f
+}
@@ -20,12 +20,21 @@ type Handler interface {
 	SetRenderFolders(enable bool)
 }
 
+// DigestSetter is implemented by a Handler that sends digest headers, such as
+// the one returned by GetFileServer
+type DigestSetter interface {
+	// SetDigest enable Repr-Digest and Digest headers using the algorithm
+	// DigestSHA256 or DigestSHA512, an empty algorithm disables them
+	SetDigest(algorithm string)
+}
+
 type server struct {
 	http.FileSystem
 	notFound      http.Handler
 	permission    http.Handler
 	sys           http.Handler
 	renderFolders bool
+	digest        string
 }
 
 // GetFileServer create a http.handler
@@ -56,6 +65,11 @@ func (s *server) SetRenderFolders(enable bool) {
 	s.renderFolders = enable
 }
 
+// SetDigest enable Repr-Digest and Digest headers
+func (s *server) SetDigest(algorithm string) {
+	s.digest = algorithm
+}
+
 // ServeHTTP implement http.Handler interface
 func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	const indexPage = "/index.html"
@@ -128,6 +142,10 @@ func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if rd, ok := f.(*reader); ok {
+		rd.digestAlgorithm = s.digest
+	}
+
 	// If the files implements a http.Handler user that otherwise pass to http.ServerContent
 	if handler, ok := f.(http.Handler); ok {
 		handler.ServeHTTP(w, r)
@@ -177,16 +195,18 @@ func localRedirect(w http.ResponseWriter, r *http.Request, newPath string) {
 
 // ServeHTTP set various headers etag, content type and serve file
 func (f *reader) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var encoding string
+
 	tag := f.tag
 
-	// Check is requesting compressed and we have it compressed
-	if f.compressed && strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
-		w.Header().Set("Content-Encoding", "gzip")
-		f.readCompressed = true
-		f.length = int64(len(f.data))
-	} else {
-		if len(tag) > 3 {
-			tag = tag[:len(tag)-3]
+	// Serve the smallest encoding the request accepts
+	if len(f.encodings) > 0 {
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		if encoding = acceptEncoding(r.Header.Get("Accept-Encoding"), f.Encodings()); len(encoding) > 0 {
+			w.Header().Set("Content-Encoding", encoding)
+			f.encoded = f.file.encoded(encoding)
+			f.length = int64(len(f.encoded))
 		}
 	}
 
@@ -194,10 +214,79 @@ func (f *reader) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", f.mimeType)
 	}
 
+	// Each representation has its own tag
 	if len(tag) > 0 {
+		if len(encoding) > 0 {
+			tag += "-" + encoding
+		}
 		w.Header().Set("Etag", strconv.Quote(tag))
 	}
 
+	if len(f.digestAlgorithm) > 0 {
+		if digest := f.digest(f.digestAlgorithm, encoding); len(digest) > 0 {
+			w.Header().Set("Repr-Digest", f.digestAlgorithm+"=:"+digest+":")
+			w.Header().Set("Digest", strings.ToUpper(f.digestAlgorithm)+"="+digest)
+		}
+	}
+
 	// ServeContent will check modification time
 	http.ServeContent(w, r, f.Name(), f.ModTime(), f)
 }
+
+// acceptEncoding returns the first of the encodings with the highest quality in
+// the Accept-Encoding header, empty if none are acceptable or identity, the
+// contents as is, has a higher quality
+func acceptEncoding(header string, encodings []string) (encoding string) {
+	if len(header) == 0 {
+		return
+	}
+
+	accept := make(map[string]float64)
+
+	for _, entry := range strings.Split(header, ",") {
+		q := 1.0
+		params := strings.Split(entry, ";")
+
+		for _, param := range params[1:] {
+			if v := strings.TrimSpace(param); strings.HasPrefix(v, "q=") {
+				if f, err := strconv.ParseFloat(v[2:], 64); err == nil {
+					q = f
+				}
+			}
+		}
+
+		name := strings.ToLower(strings.TrimSpace(params[0]))
+
+		// x-gzip is the same coding as gzip
+		if name == "x-gzip" {
+			name = gzipEncoding
+		}
+
+		if previous, ok := accept[name]; !ok || q > previous {
+			accept[name] = q
+		}
+	}
+
+	// identity is acceptable unless excluded, the encodings are preferred at the same quality
+	identity, ok := accept["identity"]
+	if !ok {
+		if identity, ok = accept["*"]; !ok {
+			identity = 1
+		}
+	}
+
+	best := 0.0
+
+	for _, name := range encodings {
+		q, ok := accept[name]
+		if !ok {
+			q = accept["*"]
+		}
+
+		if q > best && q >= identity {
+			encoding, best = name, q
+		}
+	}
+
+	return
+}
@@ -1,6 +1,8 @@
 package embedded
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -68,6 +70,142 @@ func TestLocalWebServe(t *testing.T) {
 		})
 	}
 }
+func TestServeDigest(t *testing.T) {
+	dir, fs := makeFs()
+	defer os.RemoveAll(dir)
+
+	// Digests may end in -gz, the tag is served unchanged
+	fs.AddFile("/suffix.html", "suffix.html", "", indexSize, setTime, mimeType, indexTag+"-gz", false, indexBytes, index)
+
+	digest := func(data []byte) string {
+		sum := sha256.Sum256(data)
+		return base64.StdEncoding.EncodeToString(sum[:])
+	}
+
+	for _, tt := range []struct {
+		name        string
+		url         string
+		requestGZip bool
+		algorithm   string
+		etag        string
+		digest      string
+		vary        string
+	}{
+		{"compressed", "/", true, DigestSHA256, `"` + indexTag + `-gzip"`, digest(indexCompressed), "Accept-Encoding"},
+		{"uncompressed", "/", false, DigestSHA256, `"` + indexTag + `"`, digest(indexBytes), "Accept-Encoding"},
+		{"not compressed", "/settings.html", true, DigestSHA256, `"` + indexTag + `"`, digest(indexBytes), ""},
+		{"tag suffix", "/suffix.html", false, "", `"` + indexTag + `-gz"`, "", ""},
+		{"no digest", "/settings.html", false, "", `"` + indexTag + `"`, "", ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := GetFileServer(fs)
+			s.(DigestSetter).SetDigest(tt.algorithm)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", tt.url, nil)
+
+			if tt.requestGZip {
+				r.Header.Add("Accept-Encoding", "gzip")
+			}
+
+			s.ServeHTTP(w, r)
+
+			header := w.Result().Header
+
+			if etag := header.Get("Etag"); etag != tt.etag {
+				t.Errorf("%q. Etag = %s, want %s", tt.name, etag, tt.etag)
+			}
+
+			if vary := header.Get("Vary"); vary != tt.vary {
+				t.Errorf("%q. Vary = %s, want %s", tt.name, vary, tt.vary)
+			}
+
+			expect := ""
+			if len(tt.digest) > 0 {
+				expect = "sha-256=:" + tt.digest + ":"
+			}
+
+			if d := header.Get("Repr-Digest"); d != expect {
+				t.Errorf("%q. Repr-Digest = %s, want %s", tt.name, d, expect)
+			}
+
+			if len(tt.digest) > 0 {
+				if d := header.Get("Digest"); d != "SHA-256="+tt.digest {
+					t.Errorf("%q. Digest = %s, want SHA-256=%s", tt.name, d, tt.digest)
+				}
+			}
+		})
+	}
+}
+
+func TestServeEncoding(t *testing.T) {
+	dir, fs := makeFs()
+	defer os.RemoveAll(dir)
+
+	brotli := []byte("brotli data")
+	fs.(EncodingAdder).AddEncoding("/index.html", "br", brotli)
+
+	s := GetFileServer(fs)
+	s.(DigestSetter).SetDigest(DigestSHA256)
+
+	for _, tt := range []struct {
+		name     string
+		accept   string
+		encoding string
+		expect   []byte
+	}{
+		{"none", "", "", indexBytes},
+		{"gzip", "gzip, deflate", "gzip", indexCompressed},
+		{"smallest", "gzip, deflate, br", "br", brotli},
+		{"quality", "gzip;q=1.0, br;q=0.5", "gzip", indexCompressed},
+		{"refused", "br;q=0, gzip", "gzip", indexCompressed},
+		{"any", "*", "br", brotli},
+		{"any refused", "*, br;q=0", "gzip", indexCompressed},
+		{"identity", "identity", "", indexBytes},
+		{"identity quality", "identity;q=1, gzip;q=0.1", "", indexBytes},
+		{"identity refused", "identity;q=0, gzip;q=0.1", "gzip", indexCompressed},
+		{"identity default", "gzip;q=0.5", "", indexBytes},
+		{"identity any", "*;q=0.5, br;q=0.4", "gzip", indexCompressed},
+		{"x-gzip", "x-gzip", "gzip", indexCompressed},
+		{"x-gzip quality", "gzip;q=0, x-gzip", "gzip", indexCompressed},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "/", nil)
+
+			if len(tt.accept) > 0 {
+				r.Header.Add("Accept-Encoding", tt.accept)
+			}
+
+			s.ServeHTTP(w, r)
+
+			header := w.Result().Header
+
+			if encoding := header.Get("Content-Encoding"); encoding != tt.encoding {
+				t.Errorf("%q. Content-Encoding = %s, want %s", tt.name, encoding, tt.encoding)
+			}
+
+			etag := `"` + indexTag + `"`
+			if len(tt.encoding) > 0 {
+				etag = `"` + indexTag + "-" + tt.encoding + `"`
+			}
+
+			if tag := header.Get("Etag"); tag != etag {
+				t.Errorf("%q. Etag = %s, want %s", tt.name, tag, etag)
+			}
+
+			sum := sha256.Sum256(tt.expect)
+			if d, expect := header.Get("Repr-Digest"), "sha-256=:"+base64.StdEncoding.EncodeToString(sum[:])+":"; d != expect {
+				t.Errorf("%q. Repr-Digest = %s, want %s", tt.name, d, expect)
+			}
+
+			if !reflect.DeepEqual(w.Body.Bytes(), tt.expect) {
+				t.Errorf("%q. got = (%s), want (%s)", tt.name, w.Body.Bytes(), tt.expect)
+			}
+		})
+	}
+}
+
 func TestToHTTPError(t *testing.T) {
 
 	s := GetFileServer(nil)
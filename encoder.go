@@ -0,0 +1,104 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Encoder compresses file contents with a content coding, such as br or zstd
+type Encoder interface {
+	// Encoding returns the name of the content coding used in Accept-Encoding.
+	Encoding() string
+	// Encode returns data compressed.
+	Encode(data []byte) ([]byte, error)
+}
+
+// encoded the contents of a file compressed by an Encoder
+type encoded struct {
+	file     *file
+	encoding string
+	data     []byte
+	offset   int
+	size     int
+}
+
+// checkEncoders returns an error if an encoder has no name or its name is used
+// by gzip or another encoder
+func checkEncoders(encoders []Encoder) error {
+	names := map[string]bool{"gzip": true, "identity": true}
+
+	for _, e := range encoders {
+		name := e.Encoding()
+
+		if len(name) == 0 || name != strings.ToLower(name) || strings.ContainsAny(name, " ,;") {
+			return fmt.Errorf("encoding %q is not a valid content coding", name)
+		}
+
+		if names[name] {
+			return fmt.Errorf("encoding %s is already used", name)
+		}
+
+		names[name] = true
+	}
+
+	return nil
+}
+
+// encode adds the data compressed by every encoder saving enough of its size,
+// encodings already given by precompressed siblings are kept.
+func (f *file) encode(data []byte) error {
+	for _, e := range f.encoders {
+		if f.encoded(e.Encoding()) {
+			continue
+		}
+
+		b, err := e.Encode(data)
+
+		if err != nil {
+			return fmt.Errorf("%s: %s encoding failed: %v", f.name, e.Encoding(), err)
+		}
+
+		if f.policy.worth(len(b), len(data)) {
+			f.encodings = append(f.encodings, &encoded{file: f, encoding: e.Encoding(), data: b})
+		}
+	}
+
+	return nil
+}
+
+// encoded returns true if the file has the encoding
+func (f *file) encoded(encoding string) bool {
+	for _, e := range f.encodings {
+		if e.encoding == encoding {
+			return true
+		}
+	}
+
+	return false
+}
+
+// write writes the encoded contents
+func (e *encoded) write(b *blob) (err error) {
+	e.offset, e.size, err = b.write(e.data)
+	e.data = nil
+
+	stringer.add(e.encoding)
+
+	return
+}
+
+func (e *encoded) Name() string {
+	return stringer.slice(e.file.name)
+}
+
+func (e *encoded) Encoding() string {
+	return stringer.slice(e.encoding)
+}
+
+func (e *encoded) Slice() string {
+	return fmt.Sprintf("%d:%d", e.offset, e.offset+e.size)
+}
@@ -0,0 +1,132 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+type testEncoder struct {
+	name string
+	err  error
+}
+
+func (e *testEncoder) Encoding() string {
+	return e.name
+}
+
+func (e *testEncoder) Encode(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	w, _ := zlib.NewWriterLevel(&buf, zlib.BestCompression)
+	w.Write(data)
+	w.Close()
+
+	return buf.Bytes(), nil
+}
+
+func TestEncoders(t *testing.T) {
+	base, err := ioutil.TempDir("", "encoder-test")
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	files := fstest.MapFS{
+		"index.html": &fstest.MapFile{Data: []byte(strings.Repeat("<p>Some text to compress</p>", 20))},
+		"small.txt":  &fstest.MapFile{Data: []byte("small")},
+	}
+
+	for _, test := range []struct {
+		name     string
+		encoders []Encoder
+		compress bool
+		expect   map[string][]string
+		hasErr   bool
+	}{
+		{
+			name:     "Deflate",
+			encoders: []Encoder{&testEncoder{name: "deflate"}},
+			compress: true,
+			expect:   map[string][]string{"/index.html": {"deflate"}},
+		},
+		{
+			name:     "Disabled",
+			encoders: []Encoder{&testEncoder{name: "deflate"}},
+			expect:   map[string][]string{},
+		},
+		{
+			name:     "Error",
+			encoders: []Encoder{&testEncoder{name: "deflate", err: fmt.Errorf("broken")}},
+			compress: true,
+			hasErr:   true,
+		},
+		{
+			name:     "Gzip",
+			encoders: []Encoder{&testEncoder{name: "gzip"}},
+			hasErr:   true,
+		},
+		{
+			name:     "Duplicate",
+			encoders: []Encoder{&testEncoder{name: "br"}, &testEncoder{name: "br"}},
+			hasErr:   true,
+		},
+		{
+			name:     "Bad Name",
+			encoders: []Encoder{&testEncoder{name: "Br"}},
+			hasErr:   true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var gen generate
+
+			config := New()
+			config.Output = filepath.Join(base, "assets", "files")
+			config.Encoders = test.encoders
+			config.DisableCompression = !test.compress
+			config.Sources = []Source{{FS: files}}
+
+			err := gen.generate(config)
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("Generate did not return an error")
+				}
+			} else if !test.hasErr {
+				t.Fatalf("Generate returned unexpected error %v", err)
+			}
+
+			if err == nil {
+				encodings := make(map[string][]string)
+				for _, f := range gen.Files {
+					for _, e := range f.encodings {
+						encodings[f.name] = append(encodings[f.name], e.encoding)
+					}
+				}
+
+				if !reflect.DeepEqual(encodings, test.expect) {
+					t.Errorf("Did not get expected encodings got (%v) expected (%v)", encodings, test.expect)
+				}
+			}
+		})
+	}
+}
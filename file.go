@@ -10,32 +10,42 @@ import (
 	"crypto/sha1"
 	"encoding/base64"
 	"fmt"
-	"io/ioutil"
+	"hash"
+	"io/fs"
 	"mime"
 	"net/http"
 	"os"
-	"path/filepath"
 	"sort"
-
-	"github.com/tdewolff/minify"
-	"github.com/tdewolff/minify/css"
-	"github.com/tdewolff/minify/html"
-	"github.com/tdewolff/minify/js"
-	"github.com/tdewolff/minify/svg"
 )
 
 type file struct {
 	name       string
 	baseName   string
+	fsys       fs.FS
 	path       string
 	local      string
+	localPath  string
 	Size       int
 	ModTime    int64
 	mimeType   string
 	tag        string
+	integrity  string
+	sri        []string
+	digest     func() hash.Hash
+	readSum    []byte
 	dataSize   int
 	Compressed bool
 	offset     int
+	data       []byte
+	minify     map[string]bool
+	minifier   *minifier
+	transform  *transformer
+	compress   bool
+	policy     *compressPolicy
+	encoders   []Encoder
+	encodings  []*encoded
+	siblings   []*sibling
+	logf       func(format string, v ...interface{})
 
 	fileinfo os.FileInfo
 }
@@ -49,7 +59,6 @@ type dir struct {
 }
 
 var (
-	minifier *minify.M
 	stringer builder
 )
 
@@ -59,6 +68,7 @@ func (f *file) set() {
 	stringer.add(f.local)
 	stringer.add(f.mimeType)
 	stringer.add(f.tag)
+	stringer.add(f.integrity)
 }
 
 func (f *file) Slice() string {
@@ -84,56 +94,141 @@ func (f *file) Tag() string {
 	return stringer.slice(f.tag)
 }
 
-func (f *file) write(w writer) error {
-	var (
-		buf bytes.Buffer
-		gw  *gzip.Writer
-	)
+func (f *file) Integrity() string {
+	return stringer.slice(f.integrity)
+}
+
+func (f *file) Encodings() []*encoded {
+	return f.encodings
+}
 
-	f.offset = w.offset()
-	b, err := ioutil.ReadFile(f.path)
+// process reads, minifies and compresses the file contents ready to write,
+// it does not touch shared state so files can be processed concurrently.
+func (f *file) process(c *cache) error {
+	err := f.load(c)
 
 	if err == nil {
-		// Determine mimetype
-		f.mimeType = mime.TypeByExtension(filepath.Ext(f.name))
+		err = f.finish(c)
+	}
+
+	return err
+}
+
+// load reads, transforms and minifies the file contents
+func (f *file) load(c *cache) error {
+	b, err := fs.ReadFile(f.fsys, f.path)
+
+	if err == nil {
+		// Remember the contents on disk, later steps may replace them
+		f.readSum = f.sum(b)
+
+		// Determine mimetype, if not in the table
 		if f.mimeType == "" {
 			// read a chunk to decide between utf-8 text and binary
 			f.mimeType = http.DetectContentType(b)
 		}
 
+		if f.transform != nil {
+			b, err = f.transform.transform(f, b)
+		}
+	}
+
+	if err == nil {
+		// Precompressed siblings are of the contents read, minify only once not used
+		f.checkSiblings(f.sum(b))
+	}
+
+	if err == nil {
 		// Minify the data
-		if m, e := minifier.Bytes(f.mimeType, b); e == nil {
-			b = m
+		if mediaType, _, e := mime.ParseMediaType(f.mimeType); e == nil && f.minify[mediaType] && len(f.siblings) == 0 {
+			b, err = f.minifier.minify(c, f.name, f.mimeType, b)
 		}
+	}
 
-		// Create eTag
-		hash := sha1.Sum(b)
-		f.tag = base64.RawURLEncoding.EncodeToString(hash[:]) + "-gz"
+	f.data = b
 
-		f.Size = len(b)
-		f.dataSize = f.Size
+	return err
+}
 
-		gw, err = gzip.NewWriterLevel(&buf, gzip.BestCompression)
-	}
+// finish creates the tag and compresses the loaded contents
+func (f *file) finish(c *cache) (err error) {
+	b := f.data
 
-	if err == nil {
-		_, err = gw.Write(b)
+	// Create eTag
+	sum := f.sum(b)
+	f.tag = base64.RawURLEncoding.EncodeToString(sum)
+
+	if len(f.sri) > 0 {
+		f.integrity = integrity(f.sri, b)
 	}
 
-	if err == nil {
-		err = gw.Close()
+	f.Size = len(b)
+	f.dataSize = f.Size
+
+	raw := b
+
+	// Fingerprinting may have rewritten references since
+	f.checkSiblings(sum)
+
+	if len(f.siblings) > 0 {
+		b, err = f.precompressed(raw)
 	}
 
-	if err == nil {
-		if buf.Len() < f.Size {
-			b = buf.Bytes()
-			f.dataSize = len(b)
-			f.Compressed = true
+	if err == nil && f.compress && f.policy.allow(f.mimeType, f.Size) {
+		if !f.Compressed {
+			var gz []byte
+
+			gz, err = c.process(c.key([]byte("gzip"), raw), func() ([]byte, error) {
+				return gzipBytes(raw)
+			})
+
+			if err == nil && f.policy.worth(len(gz), f.Size) {
+				b = gz
+				f.dataSize = len(b)
+				f.Compressed = true
+			}
+		}
+
+		if err == nil {
+			err = f.encode(raw)
 		}
 	}
 
-	if err == nil {
-		f.dataSize, err = w.Write(b)
+	f.data = b
+
+	return
+}
+
+// checkSiblings leaves out the precompressed siblings, with a warning, when sum
+// is not of the contents read as the siblings are of those
+func (f *file) checkSiblings(sum []byte) {
+	if len(f.siblings) > 0 && !bytes.Equal(sum, f.readSum) {
+		f.logf("warning: %s: contents changed after they were read, precompressed siblings not used", f.name)
+		f.siblings = nil
+	}
+}
+
+// sum returns the digest of data
+func (f *file) sum(data []byte) []byte {
+	newHash := f.digest
+	if newHash == nil {
+		newHash = sha1.New
+	}
+
+	h := newHash()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// write writes the processed contents and records the strings used
+func (f *file) write(b *blob) (err error) {
+	f.offset, f.dataSize, err = b.write(f.data)
+	f.data = nil
+
+	for _, e := range f.encodings {
+		if err == nil {
+			err = e.write(b)
+		}
 	}
 
 	f.set()
@@ -141,12 +236,43 @@ func (f *file) write(w writer) error {
 	return err
 }
 
+// gzipBytes compress data at the best compression level
+func gzipBytes(data []byte) (b []byte, err error) {
+	var (
+		buf bytes.Buffer
+		gw  *gzip.Writer
+	)
+
+	gw, err = gzip.NewWriterLevel(&buf, gzip.BestCompression)
+
+	if err == nil {
+		_, err = gw.Write(data)
+	}
+
+	if err == nil {
+		err = gw.Close()
+	}
+
+	if err == nil {
+		b = buf.Bytes()
+	}
+
+	return
+}
+
 func (d *dir) set() {
 	stringer.add(d.name)
 	stringer.add(d.baseName)
 	stringer.add(d.local)
 
+	keys := make([]string, 0, len(d.files))
 	for k := range d.files {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
 		stringer.add(k)
 	}
 }
@@ -180,21 +306,3 @@ func (d *dir) Files() []string {
 
 	return res
 }
-
-func init() {
-	minifier = minify.New()
-	minifier.AddFunc("text/css", css.Minify)
-	minifier.AddFunc("text/javascript", js.Minify)
-	minifier.AddFunc("application/javascript", js.Minify)
-	minifier.AddFunc("image/svg+xml", svg.Minify)
-	minifier.Add("text/html", &html.Minifier{
-		KeepConditionalComments: true,
-		KeepDocumentTags:        true,
-		KeepEndTags:             true,
-	})
-	minifier.Add("text/html; charset=utf-8", &html.Minifier{
-		KeepConditionalComments: true,
-		KeepDocumentTags:        true,
-		KeepEndTags:             true,
-	})
-}
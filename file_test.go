@@ -31,14 +31,20 @@ Some text to make this compressable Some text to make this compressable Some tex
 func TestFile(t *testing.T) {
 	base, err := ioutil.TempDir("", "file-test")
 
+	stringer = builder{}
+
 	w := mocWriter{}
 
 	f := file{
 		name:     "/scripts/test.html",
 		baseName: "test.html",
+		fsys:     os.DirFS("."),
 		path:     "test.html",
 		local:    "test.html",
 		ModTime:  1579282495,
+		minify:   minifyTypes,
+		minifier: newMinifier(New().HTML, nil),
+		compress: true,
 	}
 
 	if err == nil {
@@ -57,7 +63,11 @@ func TestFile(t *testing.T) {
 	}
 
 	if err == nil {
-		err = f.write(&w)
+		err = f.process(nil)
+	}
+
+	if err == nil {
+		err = f.write(newBlob(&w))
 	}
 
 	if err == nil {
@@ -72,11 +82,11 @@ func TestFile(t *testing.T) {
 
 	if err == nil {
 		fileTests{
-			{"Name", "/* /scripts/test.html */ str[54:72]"},
-			{"BaseName", "/* test.html */ str[63:72]"},
-			{"Local", "/* test.html */ str[63:72]"},
-			{"MimeType", "/* text/html; charset=utf-8 */ str[30:54]"},
-			{"Tag", "/* xwI1ooNerSnDfL_w9IZZoVz_A4Y-gz */ str[0:30]"},
+			{"Name", "/* /scripts/test.html */ str[51:69]"},
+			{"BaseName", "/* test.html */ str[60:69]"},
+			{"Local", "/* test.html */ str[60:69]"},
+			{"MimeType", "/* text/html; charset=utf-8 */ str[27:51]"},
+			{"Tag", "/* xwI1ooNerSnDfL_w9IZZoVz_A4Y */ str[0:27]"},
 			{"Slice", "0:98"},
 		}.run(t, &f)
 	} else {
@@ -100,10 +110,10 @@ func TestDir(t *testing.T) {
 
 	if err == nil {
 		fileTests{
-			{"Name", "/* /scripts */ str[54:62]"},
-			{"BaseName", "/* scripts */ str[55:62]"},
-			{"Local", "/* embed/scripts */ str[91:104]"},
-			{"Files", []string{"/* /scripts/index.html */ str[54:73]"}},
+			{"Name", "/* /scripts */ str[51:59]"},
+			{"BaseName", "/* scripts */ str[52:59]"},
+			{"Local", "/* embed/scripts */ str[88:101]"},
+			{"Files", []string{"/* /scripts/index.html */ str[51:70]"}},
 		}.run(t, &d)
 	} else {
 		t.Errorf("Error setting up test %v", err)
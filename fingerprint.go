@@ -0,0 +1,196 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// fingerprintSize number of hex digits of the content hash added to names
+	fingerprintSize = 8
+)
+
+// fingerprint maps a logical name to the content hashed name it is stored as
+type fingerprint struct {
+	Logical string
+	Name    string
+}
+
+var (
+	htmlReference = regexp.MustCompile(`(?i)(\s(?:src|href)\s*=\s*)("[^"]*"|'[^']*'|[^\s"'>]+)`)
+	cssReference  = regexp.MustCompile(`(?i)(url\(\s*)("[^"]*"|'[^']*'|[^\s"')]+)`)
+)
+
+// fingerprintState tracks the files being resolved to detect reference cycles
+type fingerprintState int
+
+const (
+	unresolved fingerprintState = iota
+	resolving
+	resolved
+)
+
+// fingerprintFiles renames the files matching the fingerprint pattern to include
+// a hash of their contents and rewrites references to them in html and css files.
+// Files are resolved depth first as a stylesheet's hash depends on the names of the
+// images it references.
+func (gen *generate) fingerprintFiles() (err error) {
+	var renamed []*file
+
+	files := make(map[string]*file, len(gen.Files))
+	state := make(map[*file]fingerprintState, len(gen.Files))
+	logical := make(map[*file]string)
+
+	for _, f := range gen.Files {
+		files[f.name] = f
+	}
+
+	var resolve func(f *file, from *file) error
+
+	resolve = func(f *file, from *file) (err error) {
+		switch state[f] {
+		case resolving:
+			return fmt.Errorf("%s, %s: fingerprint reference cycle", from.name, f.name)
+		case resolved:
+			return
+		}
+
+		state[f] = resolving
+
+		if re := f.references(); re != nil {
+			f.data = rewriteReferences(re, f.data, func(ref string) string {
+				name, suffix, relative := resolveReference(f.name, ref)
+				// Only fingerprinted targets change name, so pages linking to each other are not a cycle
+				if target := files[name]; target != nil && target != f && err == nil && gen.fingerprint.MatchString(name) {
+					if err = resolve(target, f); err == nil && target.name != name {
+						if relative {
+							dir := strings.TrimSuffix(ref, suffix)
+							return dir[:strings.LastIndex(dir, "/")+1] + target.baseName + suffix
+						}
+						return target.name + suffix
+					}
+				}
+				return ref
+			})
+		}
+
+		if err == nil && gen.fingerprint.MatchString(f.name) {
+			ext := path.Ext(f.name)
+
+			logical[f] = f.name
+			f.name = fmt.Sprintf("%s.%s%s", strings.TrimSuffix(f.name, ext), hex.EncodeToString(f.sum(f.data))[:fingerprintSize], ext)
+			f.baseName = path.Base(f.name)
+			renamed = append(renamed, f)
+		}
+
+		state[f] = resolved
+
+		return
+	}
+
+	for _, f := range gen.Files {
+		if err == nil {
+			err = resolve(f, nil)
+		}
+	}
+
+	// Hashed names used by other files are resolved by the conflict policy
+	var dropped map[*file]bool
+
+	if err == nil {
+		dropped, err = gen.reclaim(renamed)
+	}
+
+	if err == nil {
+		dirs := make(map[string]*dir, len(gen.Dirs))
+		for _, d := range gen.Dirs {
+			dirs[d.name] = d
+		}
+
+		for _, f := range renamed {
+			if d := dirs[path.Dir(logical[f])]; d != nil && d.files[logical[f]] {
+				delete(d.files, logical[f])
+				if !dropped[f] {
+					d.files[f.name] = true
+				}
+			}
+
+			if !dropped[f] {
+				gen.Fingerprints = append(gen.Fingerprints, fingerprint{Logical: logical[f], Name: f.name})
+			}
+		}
+
+		sort.Slice(gen.Fingerprints, func(i, j int) bool {
+			return gen.Fingerprints[i].Logical < gen.Fingerprints[j].Logical
+		})
+	}
+
+	return
+}
+
+// references returns the pattern matching references in the file, nil if it has none
+func (f *file) references() []*regexp.Regexp {
+	mediaType, _, _ := mime.ParseMediaType(f.mimeType)
+
+	switch mediaType {
+	case "text/html":
+		return []*regexp.Regexp{htmlReference, cssReference}
+	case "text/css":
+		return []*regexp.Regexp{cssReference}
+	}
+
+	return nil
+}
+
+// rewriteReferences replaces the references matched by the patterns with the result of fn
+func rewriteReferences(list []*regexp.Regexp, b []byte, fn func(ref string) string) []byte {
+	for _, re := range list {
+		b = re.ReplaceAllFunc(b, func(match []byte) []byte {
+			m := re.FindSubmatch(match)
+			ref := string(m[2])
+
+			quote := ""
+			if len(ref) > 1 && (ref[0] == '"' || ref[0] == '\'') {
+				quote = ref[:1]
+				ref = ref[1 : len(ref)-1]
+			}
+
+			if replaced := fn(ref); replaced != ref {
+				return []byte(string(m[1]) + quote + replaced + quote)
+			}
+
+			return match
+		})
+	}
+
+	return b
+}
+
+// resolveReference returns the embedded name a reference in the file name refers to,
+// the query or fragment of the reference and if the reference is relative
+func resolveReference(name string, ref string) (target string, suffix string, relative bool) {
+	if i := strings.IndexAny(ref, "?#"); i >= 0 {
+		ref, suffix = ref[:i], ref[i:]
+	}
+
+	switch {
+	case len(ref) == 0 || strings.HasPrefix(ref, "//") || strings.Contains(ref, ":"):
+		// empty, fragment only, protocol relative or has a scheme such as data: or https:
+	case strings.HasPrefix(ref, "/"):
+		target = path.Clean(ref)
+	default:
+		target = path.Join(path.Dir(name), ref)
+		relative = true
+	}
+
+	return
+}
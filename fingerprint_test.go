@@ -0,0 +1,171 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFingerprint(t *testing.T) {
+	base, err := ioutil.TempDir("", "fingerprint-test")
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	hash := func(s string) string {
+		sum := sha1.Sum([]byte(s))
+		return hex.EncodeToString(sum[:])[:fingerprintSize]
+	}
+
+	const (
+		image = "image data"
+		about = `<a href="index.html">Home</a>`
+	)
+
+	css := `body{background:url(../img/bg.` + hash(image) + `.png?v=1)}`
+	index := `<link rel="stylesheet" href="assets/css/main.` + hash(css) + `.css"><img src='/assets/img/bg.` + hash(image) + `.png'><a href=about.html>About</a><a href="https://example.com/assets/css/main.css">`
+
+	app := "/assets/app." + hash("app") + ".js"
+
+	for _, test := range []struct {
+		name     string
+		files    fstest.MapFS
+		pattern  string
+		conflict string
+		expect   map[string]string
+		lookups  []fingerprint
+		hasErr   bool
+	}{
+		{
+			name: "Rewrite",
+			files: fstest.MapFS{
+				"index.html":          &fstest.MapFile{Data: []byte(strings.NewReplacer("."+hash(css), "", "."+hash(image), "").Replace(index))},
+				"about.html":          &fstest.MapFile{Data: []byte(about)},
+				"assets/css/main.css": &fstest.MapFile{Data: []byte(strings.Replace(css, "."+hash(image), "", 1))},
+				"assets/img/bg.png":   &fstest.MapFile{Data: []byte(image)},
+			},
+			expect: map[string]string{
+				"/index.html":                            index,
+				"/about.html":                            about,
+				"/assets/css/main." + hash(css) + ".css": css,
+				"/assets/img/bg." + hash(image) + ".png": image,
+			},
+			lookups: []fingerprint{
+				{"/assets/css/main.css", "/assets/css/main." + hash(css) + ".css"},
+				{"/assets/img/bg.png", "/assets/img/bg." + hash(image) + ".png"},
+			},
+		},
+		{
+			name: "Cycle",
+			files: fstest.MapFS{
+				"assets/a.css": &fstest.MapFile{Data: []byte(`@import url(b.css);`)},
+				"assets/b.css": &fstest.MapFile{Data: []byte(`@import url(a.css);`)},
+			},
+			hasErr: true,
+		},
+		{
+			name: "Collision",
+			files: fstest.MapFS{
+				"assets/app.js": &fstest.MapFile{Data: []byte("app")},
+				app[1:]:         &fstest.MapFile{Data: []byte("old")},
+			},
+			pattern: `^/assets/app\.js$`,
+			hasErr:  true,
+		},
+		{
+			name: "Collision First",
+			files: fstest.MapFS{
+				"assets/app.js": &fstest.MapFile{Data: []byte("app")},
+				app[1:]:         &fstest.MapFile{Data: []byte("old")},
+			},
+			pattern:  `^/assets/app\.js$`,
+			conflict: ConflictFirst,
+			expect:   map[string]string{app: "old"},
+		},
+		{
+			name: "Collision Last",
+			files: fstest.MapFS{
+				"assets/app.js": &fstest.MapFile{Data: []byte("app")},
+				app[1:]:         &fstest.MapFile{Data: []byte("old")},
+			},
+			pattern:  `^/assets/app\.js$`,
+			conflict: ConflictLast,
+			expect:   map[string]string{app: "app"},
+			lookups:  []fingerprint{{"/assets/app.js", app}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var gen generate
+
+			config := New()
+			config.Output = filepath.Join(base, "assets", "files")
+			config.Minify = ""
+			config.DisableCompression = true
+			config.Fingerprint = "^/assets/"
+			config.Conflict = test.conflict
+			config.Sources = []Source{{FS: test.files}}
+
+			if len(test.pattern) > 0 {
+				config.Fingerprint = test.pattern
+			}
+
+			err := gen.generate(config)
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("Generate did not return an error")
+				}
+			} else if !test.hasErr {
+				t.Fatalf("Generate returned unexpected error %v", err)
+			}
+
+			if err == nil {
+				tags := make(map[string]string)
+				for _, f := range gen.Files {
+					tags[f.name] = f.tag
+				}
+
+				if len(tags) != len(test.expect) {
+					t.Errorf("Did not get expected files got (%v) expected (%v)", tags, test.expect)
+				}
+
+				for name, expect := range test.expect {
+					sum := sha1.Sum([]byte(expect))
+					if tag, ok := tags[name]; !ok {
+						t.Errorf("File %s not embedded", name)
+					} else if tag != base64.RawURLEncoding.EncodeToString(sum[:]) {
+						t.Errorf("Did not get expected contents for %s expected (%s)", name, expect)
+					}
+				}
+
+				for _, d := range gen.Dirs {
+					for name := range d.files {
+						if _, ok := tags[name]; !ok && gen.dirNames[name] == nil {
+							t.Errorf("Directory %s lists %s which is not embedded", d.name, name)
+						}
+					}
+				}
+
+				if !reflect.DeepEqual(gen.Fingerprints, test.lookups) {
+					t.Errorf("Did not get expected fingerprints got (%v) expected (%v)", gen.Fingerprints, test.lookups)
+				}
+			}
+		})
+	}
+}
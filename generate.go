@@ -9,17 +9,20 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"io/ioutil"
+	"log"
+	"mime"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
 	"regexp"
+	"runtime"
 	"sort"
-	"strconv"
 	"strings"
+	"sync"
 	"text/template"
-	"time"
 
 	"github.com/inabyte/embed/embedded"
 	"github.com/inabyte/embed/internal/templates"
@@ -33,34 +36,118 @@ const (
 // Config contains all information needed to run embed.
 type Config struct {
 	// Output is the file to write output.
-	Output string
+	Output string `json:"output"`
 	// Package name for the generated file.
-	Package string
-	// Ignore is the regexp for files we should ignore (for example `\.DS_Store`).
-	Ignore string
+	Package string `json:"package"`
+	// Ignore is the regexp for files and directories we should ignore (for example
+	// `\.DS_Store` or `/node_modules/`). Directories are matched with a trailing
+	// separator, an ignored directory is not scanned.
+	Ignore string `json:"ignore"`
 	// Include is the regexp for files to include. If provided, only files that
 	// match will be included.
-	Include string
+	Include string `json:"include"`
 	// Minify is comma separated list of mime type to minify.
-	Minify string
-	// ModifyTime is the Unix timestamp to override as modification time for all files.
-	ModifyTime string
+	Minify string `json:"minify"`
+	// Minifiers adds or replaces the minifier of mime types, files of these types
+	// are always minified unless the source turns minification off.
+	Minifiers map[string]MinifyFunc `json:"-"`
+	// HTML are the options of the built-in HTML minifier.
+	HTML HTMLOptions `json:"html"`
+	// Commands are external commands run in order on the files matching their
+	// pattern, before the Transforms.
+	Commands []Command `json:"commands"`
+	// Transforms are run in order on the files matching their pattern before they
+	// are minified and compressed.
+	Transforms []Transform `json:"-"`
+	// StrictMinify, if true, stops generation with a *MinifyError when a file can
+	// not be minified, otherwise the file is stored as is with a warning.
+	StrictMinify bool `json:"strictMinify"`
+	// Logf, if set, is called with warnings and reports, such as the bytes saved by
+	// storing identical contents once, otherwise warnings are written with the log
+	// package and reports are left out. It may be called from several goroutines.
+	Logf func(format string, v ...interface{}) `json:"-"`
+	// ModifyTime is the Unix timestamp or RFC 3339 time to override as modification time for all files.
+	ModifyTime string `json:"modifyTime"`
+	// GitModifyTime, if true, use the last commit time of files as the modification time.
+	// Directories use the newest time of their entries, files not committed use the
+	// file modification time.
+	GitModifyTime bool `json:"gitModifyTime"`
+	// Encoders add content codings, such as br or zstd, files are also stored in each
+	// encoding when smaller than the uncompressed contents.
+	Encoders []Encoder `json:"-"`
 	// DisableCompression, if true, does not compress files.
-	DisableCompression bool
+	DisableCompression bool `json:"disableCompression"`
+	// NoCompressTypes is comma separated list of mime types, or type/* for all
+	// subtypes, never compressed such as already compressed images and fonts.
+	NoCompressTypes string `json:"noCompressTypes"`
+	// CompressMinSize is the size in bytes files must have to be compressed.
+	CompressMinSize int `json:"compressMinSize"`
+	// CompressMinSavings is the fraction of the size, between 0 and 1, compression
+	// must save for the compressed contents to be stored.
+	CompressMinSavings float64 `json:"compressMinSavings"`
+	// Precompressed, if true, stores siblings such as app.js.gz, app.js.br or app.js.zst
+	// as the gzip, br or zstd encoded contents of app.js instead of files of their own.
+	// Files with siblings are not minified, siblings are not used once a transform,
+	// command or fingerprinting changes the contents, or br and zstd siblings
+	// older than the file.
+	Precompressed bool `json:"precompressed"`
 	// Binary, if true, produce self-contained extractor/http server binary.
-	Binary bool
+	Binary bool `json:"binary"`
 	// NoRemote, if true, zero dependencies on packages outside the standard library.
-	NoRemote bool
+	NoRemote bool `json:"noRemote"`
 	// Go, if true, creates only go files.
-	Go bool
+	Go bool `json:"go"`
 	// NoLocalFS, if true, do not store local file system paths.
-	NoLocalFS bool
+	NoLocalFS bool `json:"noLocalFS"`
 	// FileServer, if true, add http.Handler to serve files.
-	FileServer bool
+	FileServer bool `json:"fileServer"`
 	// BuildTags, if set, adds a build tags entry to file.
-	BuildTags string
+	BuildTags string `json:"buildTags"`
+	// CacheDir, if set, is the directory used to cache minified and compressed
+	// file contents between runs.
+	CacheDir string `json:"cacheDir"`
+	// Workers is the number of files minified and compressed concurrently,
+	// if zero the number of CPUs is used.
+	Workers int `json:"workers"`
+	// Fingerprint is the regexp for embedded names (for example `^/assets/`) to
+	// store with a hash of the contents added to the name. References to them in
+	// html and css files are rewritten and the generated AssetPath function maps
+	// the original names to the fingerprinted names.
+	Fingerprint string `json:"fingerprint"`
+	// Digest is the hash algorithm (sha1, sha256, sha384 or sha512) used to create
+	// the tag of files, if empty sha1 is used.
+	Digest string `json:"digest"`
+	// Integrity is a comma separated list of hash algorithms (sha256, sha384 or sha512)
+	// used to compute the subresource integrity of files, if empty it is not computed.
+	Integrity string `json:"integrity"`
+	// OmitEmptyDirs, if true, directories without any embedded files or
+	// directories are left out.
+	OmitEmptyDirs bool `json:"omitEmptyDirs"`
+	// GitIgnore, if true, .gitignore files are honored as well as .embedignore
+	// files, the patterns in a .embedignore file take precedence. The .gitignore
+	// files are then not embedded.
+	GitIgnore bool `json:"gitIgnore"`
+	// Symlinks is how symbolic links are handled, SymlinksFollow (the default)
+	// embeds the file or directory linked to, SymlinksSkip ignores links and
+	// SymlinksLink stores links that resolve to their target in the embedded files.
+	Symlinks string `json:"symlinks"`
+	// Rewrite is the list of rules applied, in order, to the names files and
+	// directories are stored as (for example `^/dist/app/(.*)$` to `/static/$1`).
+	Rewrite []Rewrite `json:"rewrite"`
+	// RewriteFunc, if set, is called with each name after the Rewrite rules and
+	// returns the name to store it as (for example strings.ToLower).
+	RewriteFunc func(name string) string `json:"-"`
+	// Conflict is what happens when two files are stored with the same name,
+	// ConflictError (the default), ConflictFirst or ConflictLast.
+	Conflict string `json:"conflict"`
+	// MimeTypes maps file extensions (for example `.wasm`) to mime types, extending
+	// or overriding the built-in table. Files with other extensions have the mime
+	// type detected from their contents.
+	MimeTypes map[string]string `json:"mimeTypes"`
 	// Files is the list of files or directories to embed.
-	Files []string
+	Files []string `json:"files"`
+	// Sources is the list of file systems to embed, in addition to Files.
+	Sources []Source `json:"sources"`
 }
 
 const (
@@ -70,8 +157,14 @@ const (
 // New create new config
 func New() *Config {
 	return &Config{
-		Output: "embed",
-		Minify: "application/javascript,text/javascript,text/css,text/html,text/html; charset=utf-8",
+		Output:          "embed",
+		Minify:          "application/javascript,text/javascript,text/css,text/html,text/html; charset=utf-8,image/svg+xml",
+		NoCompressTypes: "image/png,image/jpeg,image/gif,image/webp,image/avif,font/woff,font/woff2,application/zip,application/gzip,audio/*,video/*",
+		HTML: HTMLOptions{
+			KeepConditionalComments: true,
+			KeepDocumentTags:        true,
+			KeepEndTags:             true,
+		},
 	}
 }
 
@@ -130,34 +223,64 @@ var (
 )
 
 type generate struct {
-	Remote      bool
-	PackageName string
-	Name        string
-	BuildTags   string
-	Imports     []string
-	TestImports []string
-	Main        bool
-	Go          bool
-	FileServer  bool
-	Files       []*file
-	Dirs        []*dir
-	ignore      *regexp.Regexp
-	include     *regexp.Regexp
-	imports     map[string]bool
-	testImports map[string]bool
-	minify      map[string]bool
-	modifyTime  *int64
-	prefix      string
-	compress    bool
-	Offset      int
-	processed   map[string]bool
-	config      *Config
-	last        time.Time
-}
-
-// Count return count of files and directories
+	Remote       bool
+	PackageName  string
+	Name         string
+	BuildTags    string
+	Imports      []string
+	TestImports  []string
+	Main         bool
+	Go           bool
+	FileServer   bool
+	Files        []*file
+	Dirs         []*dir
+	Links        []*link
+	Fingerprint  bool
+	Fingerprints []fingerprint
+	Integrity    bool
+	DigestNew    string
+	ignore       *regexp.Regexp
+	include      *regexp.Regexp
+	fingerprint  *regexp.Regexp
+	integrity    []string
+	digest       digestType
+	symlinks     string
+	rewrites     []rewriteRule
+	conflict     string
+	imports      map[string]bool
+	testImports  map[string]bool
+	minify       map[string]bool
+	minifier     *minifier
+	transform    *transformer
+	mimeTypes    map[string]string
+	modifyTime   *int64
+	compress     bool
+	compressing  *compressPolicy
+	cache        *cache
+	check        *checker
+	Offset       int
+	processed    map[string]bool
+	dirNames     map[string]*dir
+	config       *Config
+	logf         func(format string, v ...interface{})
+	last         int64
+	epoch        *int64
+	gitTimes     *gitTimes
+}
+
+// Encoded returns true if a file is stored in an encoding other than gzip
+func (gen *generate) Encoded() bool {
+	for _, f := range gen.Files {
+		if len(f.encodings) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Count return count of files, directories and links
 func (gen *generate) Count() int {
-	return len(gen.Files) + len(gen.Dirs)
+	return len(gen.Files) + len(gen.Dirs) + len(gen.Links)
 }
 
 func (gen *generate) init(config *Config) (err error) {
@@ -170,13 +293,26 @@ func (gen *generate) init(config *Config) (err error) {
 
 	gen.Go = config.Go
 
+	gen.logf = config.Logf
+	if gen.logf == nil {
+		gen.logf = log.Printf
+	}
+
+	gen.minifier = newMinifier(config.HTML, config.Minifiers)
+	gen.minifier.strict = config.StrictMinify
+	gen.minifier.logf = gen.logf
+
 	gen.minify = make(map[string]bool)
-	for _, e := range strings.Split(config.Minify, ",") {
-		s := strings.TrimSpace(e)
-		if len(s) > 0 {
+	for _, entry := range strings.Split(config.Minify, ",") {
+		if s, _, e := mime.ParseMediaType(entry); e == nil {
 			gen.minify[s] = true
 		}
 	}
+	for k := range gen.minifier.custom {
+		gen.minify[k] = true
+	}
+
+	gen.mimeTypes = newMimeTypes(config.MimeTypes)
 
 	gen.BuildTags = config.BuildTags
 
@@ -191,17 +327,33 @@ func (gen *generate) init(config *Config) (err error) {
 
 	gen.Files = make([]*file, 0, 10)
 	gen.Dirs = make([]*dir, 0, 10)
+	gen.Links = nil
 	gen.processed = make(map[string]bool, 10)
+	gen.dirNames = make(map[string]*dir, 10)
 	gen.compress = !config.DisableCompression
+	gen.cache = newCache(config.CacheDir)
+	if gen.cache != nil && gen.check != nil {
+		// Check writes nothing, cached results are still used
+		gen.cache.readOnly = true
+	}
+	stringer = builder{}
 
 	if config.ModifyTime != "" {
-		if i, e := strconv.ParseInt(config.ModifyTime, 10, 64); e != nil {
-			err = fmt.Errorf("ModifyTime must be an integer: %v", e)
+		if i, e := parseTime(config.ModifyTime); e != nil {
+			err = fmt.Errorf("ModifyTime %v", e)
 		} else {
 			gen.modifyTime = &i
 		}
 	}
 
+	if err == nil {
+		gen.epoch, err = sourceDateEpoch()
+	}
+
+	if config.GitModifyTime {
+		gen.gitTimes = newGitTimes()
+	}
+
 	if err == nil && config.Ignore != "" {
 		gen.ignore, err = regexp.Compile(config.Ignore)
 	}
@@ -210,14 +362,59 @@ func (gen *generate) init(config *Config) (err error) {
 		gen.include, err = regexp.Compile(config.Include)
 	}
 
+	if err == nil {
+		gen.symlinks, err = parseSymlinks(config.Symlinks)
+	}
+
+	if err == nil {
+		gen.rewrites, err = parseRewrites(config.Rewrite)
+	}
+
+	if err == nil {
+		gen.conflict, err = parseConflict(config.Conflict)
+	}
+
+	if err == nil {
+		err = checkEncoders(config.Encoders)
+	}
+
+	if err == nil {
+		gen.compressing, err = newCompressPolicy(config.NoCompressTypes, config.CompressMinSize, config.CompressMinSavings)
+	}
+
+	if err == nil {
+		gen.transform, err = newTransformer(config.Commands, config.Transforms, gen.mimeTypes, gen.cache)
+	}
+
+	if err == nil && gen.transform != nil {
+		gen.transform.fixedTime = gen.modifyTime != nil || gen.gitTimes != nil
+		gen.transform.epoch = gen.epoch
+	}
+
+	if err == nil {
+		gen.digest, err = parseDigest(config.Digest)
+		gen.DigestNew = gen.digest.newFunc
+	}
+
+	if err == nil {
+		gen.integrity, err = parseIntegrity(config.Integrity)
+		gen.Integrity = len(gen.integrity) > 0
+	}
+
+	if err == nil && config.Fingerprint != "" {
+		gen.fingerprint, err = regexp.Compile(config.Fingerprint)
+		gen.Fingerprint = true
+	}
+
 	gen.imports = map[string]bool{"unsafe": true}
 	gen.testImports = map[string]bool{
 		"bytes":           true,
-		"crypto/sha1":     true,
 		"encoding/base64": true,
 		"io":              true,
 		"strings":         true,
 		"testing":         true,
+		"testing/fstest":  true,
+		gen.digest.pkg:    true,
 	}
 
 	if gen.Main {
@@ -251,17 +448,46 @@ func (gen *generate) skip(name string) bool {
 	return true
 }
 
-func (gen *generate) setLast(fi os.FileInfo) {
-	if gen.last.Before(fi.ModTime()) {
-		gen.last = fi.ModTime()
+// skipDir returns true if the directory rel relative to the root is excluded by the
+// Ignore regexp, matched with a trailing separator, or the ignore patterns of the source.
+// Skipped directories are not scanned.
+func (gen *generate) skipDir(src *source, rel string) bool {
+	if len(rel) == 0 {
+		return false
+	}
+
+	if gen.ignore != nil && gen.ignore.MatchString(src.dirPath(rel)) {
+		return true
+	}
+
+	if src.glob != nil && !matchGlobDir(src.glob, rel) {
+		return true
+	}
+
+	return match(src.ignore, rel)
+}
+
+func (gen *generate) setLast(m int64) {
+	if gen.last < m {
+		gen.last = m
 	}
 }
 
-func (gen *generate) getModTime(t time.Time) (m int64) {
-	m = t.Unix()
+// getModTime returns the modification time to store for the local file,
+// in order of precedence Config.ModifyTime, the git commit time or the file
+// modification time clamped to SOURCE_DATE_EPOCH.
+func (gen *generate) getModTime(local string, fi fs.FileInfo) (m int64) {
+	var ok bool
+
 	if gen.modifyTime != nil {
 		m = *gen.modifyTime
+	} else if m, ok = gen.gitTimes.lookup(local); !ok {
+		m = fi.ModTime().Unix()
+		if gen.epoch != nil && m > *gen.epoch {
+			m = *gen.epoch
+		}
 	}
+
 	return
 }
 
@@ -275,101 +501,253 @@ func (gen *generate) checkProcessed(name string, fpath string) (err error) {
 	return
 }
 
-func (gen *generate) canonicalName(fname string) string {
-	fpath := filepath.ToSlash(fname)
-	return path.Join("/", strings.TrimPrefix(fpath, gen.prefix))
-}
-
-func (gen *generate) scan(fpath string, f *os.File, fi os.FileInfo) (skip bool, err error) {
+func (gen *generate) scan(src *source, rel string, fi fs.FileInfo) (modTime int64, skip bool, err error) {
 	var local string
 
-	n := gen.canonicalName(fpath)
-	err = gen.checkProcessed(n, fpath)
+	n := gen.rename(src.canonicalName(rel))
+	fpath := src.path(rel)
 	if !gen.config.NoLocalFS {
-		local = fpath
+		local = src.localPath(rel)
 	}
 
-	if err == nil {
-		gen.setLast(fi)
+	modTime = gen.getModTime(src.localPath(rel), fi)
 
-		if fi.IsDir() {
-			var (
-				fis     []os.FileInfo
-				sub     *os.File
-				skipped bool
-			)
+	switch {
+	case fi.IsDir() && gen.skipDir(src, rel):
+		skip = true
+	case fi.IsDir():
+		var (
+			entries []fs.DirEntry
+			info    fs.FileInfo
+			skipped bool
+			m       int64
+			newest  int64
+		)
 
-			fis, err = f.Readdir(0)
+		// Sources sharing a directory are an error, unless the conflict
+		// policy keeps a file, then their entries are merged
+		d := gen.dirNames[n]
+		merged := d != nil
+		switch {
+		case merged && gen.conflict == ConflictError:
+			err = gen.checkProcessed(n, fpath)
+		case !merged:
+			d = &dir{
+				name:     n,
+				baseName: path.Base(n),
+				local:    local,
+				files:    make(map[string]bool),
+			}
+			if err = gen.checkProcessed(n, fpath); err == nil {
+				gen.dirNames[n] = d
+			}
+		}
 
-			if err == nil {
-				d := &dir{
-					name:     n,
-					baseName: path.Base(n),
-					local:    local,
-					ModTime:  gen.getModTime(fi.ModTime()),
-					files:    make(map[string]bool, len(fis)),
-				}
-				gen.Dirs = append(gen.Dirs, d)
-				for _, fi := range fis {
-					if err == nil {
-						name := filepath.Join(fpath, fi.Name())
-						if sub, err = os.Open(name); err == nil {
-							if skipped, err = gen.scan(name, sub, fi); err == nil && !skipped {
-								d.files[gen.canonicalName(name)] = true
-							}
-							sub.Close()
+		if err == nil {
+			entries, err = fs.ReadDir(src.fsys, src.name(rel))
+		}
+
+		depth := len(src.ignores)
+		if err == nil {
+			err = src.readIgnores(rel, gen.config.GitIgnore)
+		}
+
+		if err == nil {
+			src.dirs = append(src.dirs, scanDir{rel: rel, info: fi})
+			for _, entry := range entries {
+				if err == nil {
+					name := path.Join(rel, entry.Name())
+					if entry.Name() == EmbedIgnore || (gen.config.GitIgnore && entry.Name() == GitIgnore) || src.ignored(name, entry.IsDir()) {
+						skipped = true
+					} else if entry.Type()&fs.ModeSymlink != 0 {
+						m, skipped, err = gen.scanLink(src, name, entry)
+					} else if info, err = fs.Stat(src.fsys, src.name(name)); err == nil {
+						m, skipped, err = gen.scan(src, name, info)
+					}
+
+					if err == nil && !skipped {
+						d.files[src.canonicalName(name)] = true
+						if newest < m {
+							newest = m
 						}
 					}
 				}
-				d.set()
 			}
-		} else {
-			if skip = gen.skip(fpath); !skip {
-				if err == nil {
-					gen.Files = append(gen.Files, &file{
-						name:     n,
-						baseName: path.Base(n),
-						path:     fpath,
-						local:    local,
-						ModTime:  gen.getModTime(fi.ModTime()),
-					})
-				}
+			src.dirs = src.dirs[:len(src.dirs)-1]
+			src.ignores = src.ignores[:depth]
+
+			// Directories are not tracked by git, use the newest entry
+			if gen.gitTimes != nil && gen.modifyTime == nil && len(d.files) > 0 {
+				modTime = newest
+			}
+
+			if d.ModTime < modTime {
+				d.ModTime = modTime
+			}
+
+			switch {
+			case merged:
+			case (gen.config.OmitEmptyDirs || src.glob != nil) && len(rel) > 0 && len(d.files) == 0:
+				// Directories without embedded entries are left out if asked or for globs
+				delete(gen.dirNames, n)
+				delete(gen.processed, n)
+				skip = true
+			default:
+				gen.Dirs = append(gen.Dirs, d)
 			}
 		}
+	default:
+		if skip = gen.skip(fpath) || src.skip(rel); !skip {
+			if skip, err = gen.claim(n, fpath); err == nil && !skip {
+				gen.Files = append(gen.Files, &file{
+					name:      n,
+					baseName:  path.Base(n),
+					fsys:      src.fsys,
+					path:      src.name(rel),
+					local:     local,
+					ModTime:   modTime,
+					mimeType:  gen.mimeTypes[strings.ToLower(path.Ext(n))],
+					minify:    src.minify,
+					minifier:  gen.minifier,
+					transform: gen.transform,
+					localPath: src.localPath(rel),
+					compress:  src.compress,
+					policy:    gen.compressing,
+					encoders:  gen.config.Encoders,
+					logf:      gen.logf,
+					sri:       gen.integrity,
+					digest:    gen.digest.new,
+				})
+			}
+		}
+	}
+
+	if err == nil {
+		gen.setLast(modTime)
 	}
 
 	return
 }
 
-func (gen *generate) writeData(file *os.File) error {
+// process loads, fingerprints and compresses the files
+func (gen *generate) process() error {
+	names := make([]string, len(gen.Files))
+	for i, f := range gen.Files {
+		names[i] = f.name
+	}
+
+	err := gen.parallel(func(f *file) error { return f.load(gen.cache) })
+
+	if err == nil && gen.transform != nil {
+		err = gen.transformed(names)
+	}
 
-	writer, err := createWriter(gen.Go, gen.PackageName, gen.Name, gen.config.Output, gen.config.BuildTags)
+	if err == nil && gen.fingerprint != nil {
+		err = gen.fingerprintFiles()
+	}
 
 	if err == nil {
-		defer writer.Close()
+		err = gen.parallel(func(f *file) error { return f.finish(gen.cache) })
+	}
 
-		for _, entry := range gen.Files {
-			if err == nil {
-				err = entry.write(writer)
+	return err
+}
+
+// parallel runs fn for every file on a pool of workers, the error
+// returned is the first in file order so it does not depend on scheduling.
+func (gen *generate) parallel(fn func(f *file) error) error {
+	var wg sync.WaitGroup
+
+	workers := gen.config.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(gen.Files) {
+		workers = len(gen.Files)
+	}
+
+	errs := make([]error, len(gen.Files))
+	next := make(chan int)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range next {
+				errs[index] = fn(gen.Files[index])
 			}
+		}()
+	}
+
+	for index := range gen.Files {
+		next <- index
+	}
+
+	close(next)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
 		}
 	}
 
+	return nil
+}
+
+func (gen *generate) writeData() error {
+	var writer writer
+
+	data, err := createDataFile(gen.Go, gen.config.Output)
+
 	if err == nil {
-		err = stringer.write(writer)
+		data.check = gen.check
+		writer, err = newWriter(data, gen.Go, gen.PackageName, gen.Name, gen.config.BuildTags)
 	}
 
-	gen.Offset = writer.offset()
+	if err == nil {
+		b := newBlob(writer)
+
+		for _, entry := range gen.Files {
+			if err == nil {
+				err = entry.write(b)
+			}
+		}
+
+		// Reports are only wanted by callers that asked for them
+		if err == nil && b.copies > 0 && gen.config.Logf != nil {
+			gen.logf("deduplicated %d copies of identical contents, saved %d bytes", b.copies, b.saved)
+		}
+
+		for _, entry := range gen.Links {
+			entry.set()
+		}
+
+		for _, entry := range gen.Dirs {
+			entry.set()
+		}
+
+		if err == nil {
+			err = stringer.write(writer)
+		}
+
+		gen.Offset = writer.offset()
+
+		if e := writer.Close(); err == nil {
+			err = e
+		}
+	}
 
 	return err
 }
 
 func (gen *generate) writeFiles() (err error) {
-	var file *os.File
+	var file *outputFile
 
 	file, err = createFile(gen.config.Output, "", ".go")
 
 	if err == nil {
+		file.check = gen.check
 		err = tmpl.Execute(file, gen)
 	}
 
@@ -378,7 +756,9 @@ func (gen *generate) writeFiles() (err error) {
 	}
 
 	if file != nil {
-		file.Close()
+		if e := file.Close(); err == nil {
+			err = e
+		}
 		file = nil
 	}
 
@@ -387,6 +767,7 @@ func (gen *generate) writeFiles() (err error) {
 	}
 
 	if err == nil {
+		file.check = gen.check
 		err = testTmpl.Execute(file, gen)
 	}
 
@@ -395,14 +776,16 @@ func (gen *generate) writeFiles() (err error) {
 	}
 
 	if file != nil {
-		file.Close()
+		if e := file.Close(); err == nil {
+			err = e
+		}
 		file = nil
 	}
 
 	return
 }
 
-func (gen *generate) appendFiles(out *os.File, tests bool) {
+func (gen *generate) appendFiles(out io.Writer, tests bool) {
 	templates.FS.Walk("/", func(path string, info embedded.FileInfo, err error) error {
 
 		if !info.IsDir() {
@@ -497,7 +880,7 @@ func (gen *generate) addVirtualDirs() error {
 					name:     fpath,
 					baseName: path.Base(fpath),
 					local:    "",
-					ModTime:  gen.getModTime(gen.last),
+					ModTime:  gen.last,
 					files:    make(map[string]bool),
 				}
 
@@ -518,34 +901,62 @@ func (gen *generate) addVirtualDirs() error {
 func (gen *generate) generate(config *Config) error {
 	err := gen.init(config)
 
-	for _, entry := range config.Files {
+	for _, entry := range config.sources() {
 		if err == nil {
 			var (
-				f  *os.File
-				fi os.FileInfo
+				src *source
+				fi  fs.FileInfo
 			)
 
-			fpath := entry
+			if src, err = newSource(entry); err == nil {
+				src.minify = gen.minify
+				if entry.Minify != nil {
+					src.minify = nil
+					if *entry.Minify {
+						src.minify = gen.minifier.types
+					}
+				}
 
-			gen.prefix = fpath
-			if n := strings.Index(gen.prefix, PrefixMarker); n >= 0 {
-				gen.prefix = gen.prefix[:n]
-				fpath = strings.Replace(fpath, PrefixMarker, "", 1)
-			}
+				src.compress = gen.compress
+				if entry.Compress != nil {
+					src.compress = *entry.Compress
+				}
 
-			if f, err = os.Open(fpath); err == nil {
-				if fi, err = f.Stat(); err == nil {
-					_, err = gen.scan(fpath, f, fi)
+				if len(src.local) > 0 && gen.gitTimes != nil && gen.modifyTime == nil {
+					err = gen.gitTimes.load(src.local)
+				}
+
+				if err == nil {
+					fi, err = fs.Stat(src.fsys, src.root)
+				}
+
+				if err == nil {
+					_, _, err = gen.scan(src, "", fi)
 				}
-				f.Close()
 			}
 		}
 	}
 
+	if err == nil && gen.rewriting() {
+		gen.reparent()
+	}
+
+	if err == nil && config.Precompressed {
+		gen.precompressed()
+	}
+
 	if err == nil && len(gen.Files) == 0 {
 		err = errors.New("Files empty")
 	}
 
+	if err == nil {
+		err = gen.process()
+	}
+
+	if err == nil {
+		err = gen.resolveLinks()
+	}
+
 	if err == nil {
 
 		if !gen.Remote {
@@ -575,13 +986,17 @@ func (gen *generate) generate(config *Config) error {
 			return strings.Compare(gen.Files[i].name, gen.Files[j].name) == -1
 		})
 
+		sort.Slice(gen.Links, func(i, j int) bool {
+			return strings.Compare(gen.Links[i].name, gen.Links[j].name) == -1
+		})
+
 		sort.Slice(gen.Dirs, func(i, j int) bool {
 			return strings.Compare(gen.Dirs[i].name, gen.Dirs[j].name) >= 0
 		})
 	}
 
 	if err == nil {
-		err = gen.writeData(nil)
+		err = gen.writeData()
 	}
 
 	if err == nil {
@@ -608,7 +1023,35 @@ var FS {{ if .Remote }}embedded.{{ end }}FileSystem
 func FileHandler() {{ if .Remote }}embedded.{{ end }}Handler {
 	return {{ if .Remote }}embedded.{{ end }}GetFileServer(FS)
 }
-{{end}}
+{{end}}{{ if .Fingerprint }}
+// fingerprints maps the names of files to their content hashed names
+var fingerprints = map[string]string{ {{- range .Fingerprints }}
+	{{ printf "%q" .Logical }}: {{ printf "%q" .Name }},{{ end }}
+}
+
+// AssetPath returns the content hashed name of the file name,
+// names not fingerprinted are returned unchanged
+func AssetPath(name string) string {
+	if s, ok := fingerprints[name]; ok {
+		return s
+	}
+	return name
+}
+{{ end }}{{ if .Integrity }}
+// Integrity returns the subresource integrity of the named file for use in
+// integrity attributes, empty if the file is not found or read from disk
+func Integrity(name string) string {
+	if f, err := FS.Open(name); err == nil {
+		defer f.Close()
+		if info, err := f.Stat(); err == nil {
+			if i, ok := info.({{ if .Remote }}embedded.{{ end }}IntegrityInfo); ok {
+				return i.Integrity()
+			}
+		}
+	}
+	return ""
+}
+{{ end }}
 {{ if not .Go }}var {{ .Name }}Data [{{ .Offset }}]byte
 
 {{ end }}func init() {
@@ -625,6 +1068,15 @@ func FileHandler() {{ if .Remote }}embedded.{{ end }}Handler {
 	str := *(*string)(unsafe.Pointer(&bytes))
 {{ end}}
 	FS = {{ if .Remote }}embedded.{{ end }}New({{ .Count  }})
+{{- if .Integrity }}
+	integrity := FS.({{ if .Remote }}embedded.{{ end }}IntegritySetter)
+{{- end }}
+{{- if .Links }}
+	links := FS.({{ if .Remote }}embedded.{{ end }}LinkAdder)
+{{- end }}
+{{- if .Encoded }}
+	encodings := FS.({{ if .Remote }}embedded.{{ end }}EncodingAdder)
+{{- end }}
 {{ range .Files }}
 	FS.AddFile( {{ .Name }},
 		{{ .BaseName }},
@@ -633,6 +1085,21 @@ func FileHandler() {{ if .Remote }}embedded.{{ end }}Handler {
 		{{ .MimeType }},
 		{{ .Tag }},
 		{{ .Compressed }}, bytes[{{ .Slice  }}], str[{{ .Slice  }}])
+{{- if $.Integrity }}
+	integrity.SetIntegrity( {{ .Name }},
+		{{ .Integrity }})
+{{- end }}
+{{- range .Encodings }}
+	encodings.AddEncoding( {{ .Name }},
+		{{ .Encoding }},
+		bytes[{{ .Slice }}])
+{{- end }}
+{{ end -}}
+{{ range .Links }}
+	links.AddLink( {{ .Name }},
+		{{ .BaseName }},
+		{{ .Target }},
+		{{ .ModTime }})
 {{ end -}}
 {{ range .Dirs }}
 	FS.AddFolder( {{ .Name }},
@@ -721,7 +1188,7 @@ func TestFileServer(t *testing.T) {
 {{ end}}
 func TestBytes(t *testing.T) {
 	FS.Walk("/", func(path string, info {{ if .Remote }}embedded.{{ end }}FileInfo, err error) error {
-		if !info.IsDir() {
+		if info.Mode().IsRegular() {
 			t.Run(path[1:], func(t *testing.T) {
 				if tag := getTag(bytes.NewReader(info.Bytes())); tag != info.Tag() {
 					t.Errorf("checksum {%s} for file %s doesn't match recorded {%s}", tag, path, info.Tag())
@@ -734,7 +1201,7 @@ func TestBytes(t *testing.T) {
 
 func TestString(t *testing.T) {
 	FS.Walk("/", func(path string, info {{ if .Remote }}embedded.{{ end }}FileInfo, err error) error {
-		if !info.IsDir() {
+		if info.Mode().IsRegular() {
 			t.Run(path[1:], func(t *testing.T) {
 				s := info.String()
 				if tag := getTag(strings.NewReader(s)); tag != info.Tag() {
@@ -748,7 +1215,7 @@ func TestString(t *testing.T) {
 
 func TestOpen(t *testing.T) {
 	FS.Walk("/", func(path string, info {{ if .Remote }}embedded.{{ end }}FileInfo, err error) error {
-		if !info.IsDir() {
+		if info.Mode().IsRegular() {
 			t.Run(path[1:], func(t *testing.T) {
 				f, err := FS.Open(path)
 				if err != nil {
@@ -765,11 +1232,26 @@ func TestOpen(t *testing.T) {
 	})
 }
 
+func TestFS(t *testing.T) {
+	var list []string
+
+	FS.Walk("/", func(path string, info {{ if .Remote }}embedded.{{ end }}FileInfo, err error) error {
+		if info.Mode().IsRegular() {
+			list = append(list, path[1:])
+		}
+		return nil
+	})
+
+	if err := fstest.TestFS({{ if .Remote }}embedded.{{ end }}IOFS(FS), list...); err != nil {
+		t.Error(err)
+	}
+}
+
 func getTag(r io.Reader) string {
-	h := sha1.New()
+	h := {{ .DigestNew }}()
 	io.Copy(h, r)
 	hash := h.Sum(nil)
-	return base64.RawURLEncoding.EncodeToString(hash[:]) + "-gz"
+	return base64.RawURLEncoding.EncodeToString(hash[:])
 }
 `
 )
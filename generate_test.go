@@ -6,10 +6,14 @@ package embed
 
 import (
 	"fmt"
+	"io/fs"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"testing"
+	"testing/fstest"
 	"time"
 )
 
@@ -96,6 +100,104 @@ func TestGenerate(t *testing.T) {
 				return func() { config.Output = oldOutput; config.Binary = false; os.Chdir(old) }
 			},
 		},
+		{
+			name: "Sources",
+			doFunc: func() func() {
+				config.Sources = []Source{{
+					FS: fstest.MapFS{
+						"static/css/main.css": &fstest.MapFile{Data: []byte("body {}")},
+						"static/index.html":   &fstest.MapFile{Data: []byte("<html></html>")},
+					},
+					Path: "static<->/css",
+				}}
+				return func() { config.Sources = nil }
+			},
+		},
+		{
+			name:   "Sources Duplicates",
+			hasErr: true,
+			doFunc: func() func() {
+				config.Sources = []Source{{
+					FS: fstest.MapFS{
+						"www/index.html": &fstest.MapFile{Data: []byte("<html></html>")},
+					},
+				}}
+				return func() { config.Sources = nil }
+			},
+		},
+		{
+			name: "Cache",
+			doFunc: func() func() {
+				config.CacheDir = filepath.Join(base, "cache")
+				return func() { config.CacheDir = "" }
+			},
+		},
+		{
+			name: "Cached",
+			doFunc: func() func() {
+				config.CacheDir = filepath.Join(base, "cache")
+				return func() { config.CacheDir = "" }
+			},
+		},
+		{
+			name: "Digest",
+			doFunc: func() func() {
+				config.Digest = "sha256"
+				return func() { config.Digest = "" }
+			},
+		},
+		{
+			name:   "Bad Digest",
+			hasErr: true,
+			doFunc: func() func() {
+				config.Digest = "md5"
+				return func() { config.Digest = "" }
+			},
+		},
+		{
+			name:   "Strict Minify",
+			hasErr: true,
+			doFunc: func() func() {
+				config.StrictMinify = true
+				config.Logf = t.Logf
+				config.Minifiers = map[string]MinifyFunc{
+					"text/html": func(string, []byte) ([]byte, error) { return nil, fmt.Errorf("broken") },
+				}
+				return func() { config.StrictMinify, config.Logf, config.Minifiers = false, nil, nil }
+			},
+		},
+		{
+			name: "Lenient Minify",
+			doFunc: func() func() {
+				config.Logf = t.Logf
+				config.Minifiers = map[string]MinifyFunc{
+					"text/html": func(string, []byte) ([]byte, error) { return nil, fmt.Errorf("broken") },
+				}
+				return func() { config.Logf, config.Minifiers = nil, nil }
+			},
+		},
+		{
+			name: "Integrity",
+			doFunc: func() func() {
+				config.Integrity = "sha384"
+				return func() { config.Integrity = "" }
+			},
+		},
+		{
+			name:   "Bad Integrity",
+			hasErr: true,
+			doFunc: func() func() {
+				config.Integrity = "md5"
+				return func() { config.Integrity = "" }
+			},
+		},
+		{
+			name: "Serial",
+			doFunc: func() func() {
+				config.Workers = 1
+				return func() { config.Workers = 0 }
+			},
+		},
 		{
 			name: "Go",
 			doFunc: func() func() {
@@ -121,6 +223,144 @@ func TestGenerate(t *testing.T) {
 	}
 }
 
+func TestGenerateWorkers(t *testing.T) {
+	base, err := ioutil.TempDir("", "workers-test")
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	var expect [][]byte
+
+	for _, workers := range []int{1, 2, 8} {
+		t.Run(fmt.Sprintf("Workers %d", workers), func(t *testing.T) {
+			config := New()
+			config.Output = filepath.Join(base, "assets", "files")
+			config.Files = []string{"testdata"}
+			config.ModifyTime = "1"
+			config.Workers = workers
+
+			if err := config.Generate(); err != nil {
+				t.Fatalf("Generate returned unexpected error %v", err)
+			}
+
+			var output [][]byte
+			for _, name := range []string{"files.go", "files_data.s", "files_test.go"} {
+				b, _ := ioutil.ReadFile(filepath.Join(base, "assets", name))
+				output = append(output, b)
+			}
+
+			if expect == nil {
+				expect = output
+			} else if !reflect.DeepEqual(output, expect) {
+				t.Errorf("Output with %d workers differs from serial output", workers)
+			}
+		})
+	}
+}
+
+// readDirFS records the directories read
+type readDirFS struct {
+	fstest.MapFS
+	read []string
+}
+
+func (r *readDirFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	r.read = append(r.read, name)
+	return r.MapFS.ReadDir(name)
+}
+
+func TestSkipDir(t *testing.T) {
+	base, err := ioutil.TempDir("", "skipdir-test")
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	files := fstest.MapFS{
+		"index.html":                &fstest.MapFile{Data: []byte("index")},
+		"node_modules/lib/index.js": &fstest.MapFile{Data: []byte("lib")},
+		"build/node_modules.txt":    &fstest.MapFile{Data: []byte("text")},
+		"build/tmp/a.tmp":           &fstest.MapFile{Data: []byte("tmp")},
+		"empty":                     &fstest.MapFile{Mode: fs.ModeDir},
+	}
+
+	for _, test := range []struct {
+		name   string
+		ignore string
+		globs  []string
+		omit   bool
+		dirs   []string
+		read   []string
+	}{
+		{
+			name: "None",
+			dirs: []string{"/", "/build", "/build/tmp", "/empty", "/node_modules", "/node_modules/lib"},
+			read: []string{".", "build", "build/tmp", "empty", "node_modules", "node_modules/lib"},
+		},
+		{
+			name:   "Regexp",
+			ignore: `(^|/)node_modules/`,
+			dirs:   []string{"/", "/build", "/build/tmp", "/empty"},
+			read:   []string{".", "build", "build/tmp", "empty"},
+		},
+		{
+			name:  "Glob",
+			globs: []string{"node_modules"},
+			dirs:  []string{"/", "/build", "/build/tmp", "/empty"},
+			read:  []string{".", "build", "build/tmp", "empty"},
+		},
+		{
+			name:   "Omit Empty",
+			ignore: `\.tmp$`,
+			globs:  []string{"node_modules"},
+			omit:   true,
+			dirs:   []string{"/", "/build"},
+			read:   []string{".", "build", "build/tmp", "empty"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var gen generate
+
+			fsys := &readDirFS{MapFS: files}
+
+			config := New()
+			config.Output = filepath.Join(base, "assets", "files")
+			config.Ignore = test.ignore
+			config.OmitEmptyDirs = test.omit
+			config.Sources = []Source{{FS: fsys, Ignore: test.globs}}
+
+			if err := gen.generate(config); err != nil {
+				t.Fatalf("Generate returned unexpected error %v", err)
+			}
+
+			var dirs []string
+			for _, d := range gen.Dirs {
+				dirs = append(dirs, d.name)
+			}
+			sort.Strings(dirs)
+
+			if !reflect.DeepEqual(dirs, test.dirs) {
+				t.Errorf("Did not get expected dirs got (%v) expected (%v)", dirs, test.dirs)
+			}
+
+			sort.Strings(fsys.read)
+
+			if !reflect.DeepEqual(fsys.read, test.read) {
+				t.Errorf("Did not get expected directories read got (%v) expected (%v)", fsys.read, test.read)
+			}
+		})
+	}
+}
+
 func createFs() (string, error) {
 	base, err := ioutil.TempDir("", "generate-test")
 
@@ -0,0 +1,155 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"errors"
+	"path"
+	"strings"
+)
+
+// globMeta the characters that make a path element a pattern
+const globMeta = "*?[{"
+
+var errBadBraces = errors.New("unbalanced braces")
+
+// splitGlob splits the slash separated fpath into the directory before the first element
+// with a pattern and the pattern that follows it, pattern is empty if fpath has none
+func splitGlob(fpath string) (base string, pattern string) {
+	parts := strings.Split(fpath, "/")
+
+	for i, part := range parts {
+		if strings.ContainsAny(part, globMeta) {
+			base = strings.Join(parts[:i], "/")
+			pattern = strings.Join(parts[i:], "/")
+
+			switch {
+			case len(base) == 0 && strings.HasPrefix(fpath, "/"):
+				base = "/"
+			case len(base) == 0:
+				base = "."
+			}
+
+			return
+		}
+	}
+
+	return fpath, ""
+}
+
+// compileGlob expands the braces in pattern and splits the alternatives in to path elements
+func compileGlob(pattern string) (glob [][]string, err error) {
+	var list []string
+
+	if list, err = expandBraces(pattern); err == nil {
+		for _, entry := range list {
+			parts := strings.Split(entry, "/")
+
+			for _, part := range parts {
+				if _, err = path.Match(part, ""); err != nil {
+					return nil, err
+				}
+			}
+
+			glob = append(glob, parts)
+		}
+	}
+
+	return
+}
+
+// expandBraces returns the patterns {a,b} alternatives in pattern expand to
+func expandBraces(pattern string) ([]string, error) {
+	start := strings.IndexByte(pattern, '{')
+
+	if start < 0 {
+		if strings.IndexByte(pattern, '}') >= 0 {
+			return nil, errBadBraces
+		}
+		return []string{pattern}, nil
+	}
+
+	var (
+		alternatives []string
+		depth        int
+	)
+
+	last := start + 1
+
+	for i := start; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '{':
+			depth++
+		case ',':
+			if depth == 1 {
+				alternatives = append(alternatives, pattern[last:i])
+				last = i + 1
+			}
+		case '}':
+			if depth--; depth == 0 {
+				var list []string
+
+				for _, alternative := range append(alternatives, pattern[last:i]) {
+					expanded, err := expandBraces(pattern[:start] + alternative + pattern[i+1:])
+					if err != nil {
+						return nil, err
+					}
+					list = append(list, expanded...)
+				}
+
+				return list, nil
+			}
+		}
+	}
+
+	return nil, errBadBraces
+}
+
+// matchGlob returns true if the slash separated name matches one of the compiled patterns
+func matchGlob(glob [][]string, name string) bool {
+	parts := strings.Split(name, "/")
+
+	for _, pattern := range glob {
+		if matchParts(pattern, parts) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchGlobDir returns true if files in the slash separated directory name, or
+// its subdirectories, may match one of the compiled patterns
+func matchGlobDir(glob [][]string, name string) bool {
+	parts := strings.Split(name, "/")
+
+	for _, pattern := range glob {
+		if matchDirParts(pattern, parts) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchDirParts returns true if the directory name matches the start of pattern
+// with elements left to match the files in it
+func matchDirParts(pattern []string, name []string) bool {
+	for ; len(name) > 0; pattern, name = pattern[1:], name[1:] {
+		if len(pattern) == 0 {
+			return false
+		}
+
+		if pattern[0] == "**" {
+			return true
+		}
+
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+	}
+
+	return len(pattern) > 0
+}
@@ -0,0 +1,211 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSplitGlob(t *testing.T) {
+	for _, test := range []struct {
+		fpath   string
+		base    string
+		pattern string
+	}{
+		{"web/dist", "web/dist", ""},
+		{"web/dist/**/*.{js,css}", "web/dist", "**/*.{js,css}"},
+		{"/srv/*.html", "/srv", "*.html"},
+		{"*.md", ".", "*.md"},
+		{"/[ab]/x", "/", "[ab]/x"},
+	} {
+		t.Run(test.fpath, func(t *testing.T) {
+			if base, pattern := splitGlob(test.fpath); base != test.base || pattern != test.pattern {
+				t.Errorf("Did not get expected got (%s, %s) expected (%s, %s)", base, pattern, test.base, test.pattern)
+			}
+		})
+	}
+}
+
+func TestExpandBraces(t *testing.T) {
+	for _, test := range []struct {
+		pattern string
+		expect  []string
+		hasErr  bool
+	}{
+		{"*.js", []string{"*.js"}, false},
+		{"*.{js,css}", []string{"*.js", "*.css"}, false},
+		{"{a,b}/{c,d}", []string{"a/c", "a/d", "b/c", "b/d"}, false},
+		{"x.{j{s,son},css}", []string{"x.js", "x.json", "x.css"}, false},
+		{"*.{js", nil, true},
+		{"*.js}", nil, true},
+	} {
+		t.Run(test.pattern, func(t *testing.T) {
+			list, err := expandBraces(test.pattern)
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("expandBraces did not return an error")
+				}
+			} else if !test.hasErr {
+				t.Errorf("expandBraces returned unexpected error %v", err)
+			}
+
+			if !reflect.DeepEqual(list, test.expect) {
+				t.Errorf("Did not get expected got (%v) expected (%v)", list, test.expect)
+			}
+		})
+	}
+}
+
+func TestMatchGlob(t *testing.T) {
+	for _, test := range []struct {
+		pattern string
+		name    string
+		expect  bool
+	}{
+		{"**/*.{js,css,html}", "index.html", true},
+		{"**/*.{js,css,html}", "js/lib/app.js", true},
+		{"**/*.{js,css,html}", "img/logo.png", false},
+		{"*.md", "a.md", true},
+		{"*.md", "docs/a.md", false},
+		{"docs/**", "docs/a/b.md", true},
+		{"a/**/b.txt", "a/b.txt", true},
+		{"a/**/b.txt", "a/x/y/b.txt", true},
+		{"a/**/b.txt", "b/x/b.txt", false},
+	} {
+		t.Run(test.pattern+" "+test.name, func(t *testing.T) {
+			glob, err := compileGlob(test.pattern)
+
+			if err != nil {
+				t.Fatalf("compileGlob returned unexpected error %v", err)
+			}
+
+			if m := matchGlob(glob, test.name); m != test.expect {
+				t.Errorf("Did not get expected got (%v) expected (%v)", m, test.expect)
+			}
+		})
+	}
+
+	if _, err := compileGlob("**/[.js"); err == nil {
+		t.Errorf("compileGlob did not return an error")
+	}
+}
+
+func TestMatchGlobDir(t *testing.T) {
+	for _, test := range []struct {
+		pattern string
+		name    string
+		expect  bool
+	}{
+		{"**/*.js", "lib/vendor", true},
+		{"{js,css}/*", "js", true},
+		{"{js,css}/*", "img", false},
+		{"{js,css}/*", "js/vendor", false},
+		{"src/**/*.go", "src/a/b", true},
+		{"src/**/*.go", "docs", false},
+		{"[ab]/x/*.md", "a/x", true},
+		{"[ab]/x/*.md", "a/y", false},
+	} {
+		t.Run(test.pattern+" "+test.name, func(t *testing.T) {
+			glob, err := compileGlob(test.pattern)
+
+			if err != nil {
+				t.Fatalf("compileGlob returned unexpected error %v", err)
+			}
+
+			if m := matchGlobDir(glob, test.name); m != test.expect {
+				t.Errorf("Did not get expected got (%v) expected (%v)", m, test.expect)
+			}
+		})
+	}
+}
+
+func TestGlobPrune(t *testing.T) {
+	var gen generate
+
+	files := &readDirFS{MapFS: fstest.MapFS{
+		"web/js/app.js":        &fstest.MapFile{Data: []byte("app")},
+		"web/css/main.css":     &fstest.MapFile{Data: []byte("css")},
+		"web/js/vendor/lib.js": &fstest.MapFile{Data: []byte("lib")},
+		"web/img/logo.png":     &fstest.MapFile{Data: []byte("png")},
+		"web/img/icons/x.png":  &fstest.MapFile{Data: []byte("png")},
+	}}
+
+	config := New()
+	config.Output = filepath.Join(t.TempDir(), "assets", "files")
+	config.Sources = []Source{{FS: files, Path: "web/{js,css}/*"}}
+
+	if err := gen.generate(config); err != nil {
+		t.Fatalf("Generate returned unexpected error %v", err)
+	}
+
+	sort.Strings(files.read)
+
+	if expect := []string{"web", "web/css", "web/js"}; !reflect.DeepEqual(files.read, expect) {
+		t.Errorf("Did not read expected directories got (%v) expected (%v)", files.read, expect)
+	}
+}
+
+func TestGlobSource(t *testing.T) {
+	base, err := ioutil.TempDir("", "glob-test")
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	files := fstest.MapFS{
+		"web/dist/index.html":    &fstest.MapFile{Data: []byte("index")},
+		"web/dist/js/app.js":     &fstest.MapFile{Data: []byte("app")},
+		"web/dist/img/logo.png":  &fstest.MapFile{Data: []byte("png")},
+		"web/dist/{literal}.txt": &fstest.MapFile{Data: []byte("literal")},
+		"docs/guide/start.md":    &fstest.MapFile{Data: []byte("start")},
+		"docs/guide/notes.txt":   &fstest.MapFile{Data: []byte("notes")},
+	}
+
+	var gen generate
+
+	config := New()
+	config.Output = filepath.Join(base, "assets", "files")
+	config.Sources = []Source{
+		{FS: files, Path: "web/dist/**/*.{js,css,html}"},
+		{FS: files, Path: "docs<->/guide/**/*.md"},
+		{FS: files, Path: "docs<->/**/*.md", Mount: "/manual"},
+		{FS: files, Path: "web/dist<->/{literal}.txt"},
+	}
+
+	if err := gen.generate(config); err != nil {
+		t.Fatalf("Generate returned unexpected error %v", err)
+	}
+
+	var names []string
+	for _, f := range gen.Files {
+		names = append(names, f.name)
+	}
+
+	for _, d := range gen.Dirs {
+		names = append(names, d.name)
+	}
+
+	sort.Strings(names)
+
+	expect := []string{
+		"/", "/guide", "/guide/start.md", "/index.html", "/js", "/js/app.js",
+		"/manual", "/manual/guide", "/manual/guide/start.md", "/{literal}.txt",
+	}
+
+	if !reflect.DeepEqual(names, expect) {
+		t.Errorf("Did not get expected got (%v) expected (%v)", names, expect)
+	}
+}
@@ -1,8 +1,8 @@
 module github.com/inabyte/embed
 
-go 1.13
+go 1.16
 
 require (
 	github.com/tdewolff/minify v2.3.6+incompatible
-	github.com/tdewolff/parse v2.3.4+incompatible // indirect
+	github.com/tdewolff/parse v2.3.4+incompatible
 )
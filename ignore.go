@@ -0,0 +1,162 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+const (
+	// EmbedIgnore is the name of the files, in gitignore syntax, listing the
+	// entries of the directory holding it not to embed
+	EmbedIgnore = ".embedignore"
+	// GitIgnore is the name of git ignore files, used when Config.GitIgnore is set
+	GitIgnore = ".gitignore"
+)
+
+// ignorePattern a gitignore pattern
+type ignorePattern struct {
+	base     string   // directory of the ignore file relative to the source root
+	parts    []string // pattern split at slashes
+	negate   bool     // pattern starts with !, matches are included again
+	dirOnly  bool     // pattern ends with a slash, only matches directories
+	anchored bool     // pattern has a slash, matches relative to base only
+}
+
+// parseIgnore parses the gitignore syntax patterns in data of the ignore file in directory base
+func parseIgnore(base string, data []byte) (patterns []ignorePattern) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		var p ignorePattern
+
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+
+		// Trailing spaces are ignored unless escaped
+		for strings.HasSuffix(line, " ") && !strings.HasSuffix(line, "\\ ") {
+			line = line[:len(line)-1]
+		}
+
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		if line[0] == '!' {
+			p.negate = true
+			line = line[1:]
+		} else if strings.HasPrefix(line, "\\#") || strings.HasPrefix(line, "\\!") {
+			line = line[1:]
+		}
+
+		if strings.HasSuffix(line, "/") {
+			p.dirOnly = true
+			line = strings.TrimRight(line, "/")
+		}
+
+		if strings.Contains(line, "/") {
+			p.anchored = true
+			line = strings.TrimPrefix(line, "/")
+		}
+
+		if len(line) > 0 {
+			p.base = base
+			p.parts = strings.Split(line, "/")
+			patterns = append(patterns, p)
+		}
+	}
+
+	return
+}
+
+// match returns true if the pattern matches the entry rel relative to the source root
+func (p *ignorePattern) match(rel string, isDir bool) bool {
+	if p.dirOnly && !isDir {
+		return false
+	}
+
+	if len(p.base) > 0 {
+		if !strings.HasPrefix(rel, p.base+"/") {
+			return false
+		}
+		rel = rel[len(p.base)+1:]
+	}
+
+	if !p.anchored {
+		return matchParts(p.parts, []string{path.Base(rel)})
+	}
+
+	return matchParts(p.parts, strings.Split(rel, "/"))
+}
+
+// matchParts matches the path elements name with the pattern elements, ** matches
+// any number of elements
+func matchParts(pattern []string, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			if pattern = pattern[1:]; len(pattern) == 0 {
+				return len(name) > 0
+			}
+
+			for i := range name {
+				if matchParts(pattern, name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
+
+// ignored returns true if the entry rel relative to the source root is excluded by the
+// ignore files read so far, the last matching pattern decides
+func (s *source) ignored(rel string, isDir bool) (ignored bool) {
+	for i := range s.ignores {
+		if p := &s.ignores[i]; p.negate == ignored && p.match(rel, isDir) {
+			ignored = !p.negate
+		}
+	}
+
+	return
+}
+
+// readIgnores adds the patterns of the ignore files in the directory rel relative to the root
+func (s *source) readIgnores(rel string, git bool) (err error) {
+	names := []string{EmbedIgnore}
+	if git {
+		names = []string{GitIgnore, EmbedIgnore}
+	}
+
+	for _, name := range names {
+		var data []byte
+
+		if data, err = fs.ReadFile(s.fsys, s.name(path.Join(rel, name))); err == nil {
+			s.ignores = append(s.ignores, parseIgnore(rel, data)...)
+		} else if errors.Is(err, fs.ErrNotExist) {
+			err = nil
+		} else {
+			break
+		}
+	}
+
+	return
+}
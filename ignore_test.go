@@ -0,0 +1,134 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestIgnorePattern(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		base    string
+		pattern string
+		rel     string
+		isDir   bool
+		expect  bool
+	}{
+		{"Base Name", "", "*.map", "js/app.js.map", false, true},
+		{"Base Name No Match", "", "*.map", "js/app.js", false, false},
+		{"Directory Only", "", "build/", "src/build", true, true},
+		{"Directory Only File", "", "build/", "src/build", false, false},
+		{"Anchored", "", "/todo.txt", "todo.txt", false, true},
+		{"Anchored Deeper", "", "/todo.txt", "docs/todo.txt", false, false},
+		{"Middle Slash", "", "docs/*.md", "docs/a.md", false, true},
+		{"Middle Slash Deeper", "", "docs/*.md", "x/docs/a.md", false, false},
+		{"Leading Double Star", "", "**/cache", "a/b/cache", true, true},
+		{"Leading Double Star Root", "", "**/cache", "cache", true, true},
+		{"Trailing Double Star", "", "logs/**", "logs/a/b.txt", false, true},
+		{"Trailing Double Star Dir", "", "logs/**", "logs", true, false},
+		{"Middle Double Star", "", "a/**/b", "a/x/y/b", false, true},
+		{"Middle Double Star None", "", "a/**/b", "a/b", false, true},
+		{"Base", "sub", "*.txt", "sub/a.txt", false, true},
+		{"Base Outside", "sub", "*.txt", "a.txt", false, false},
+		{"Base Anchored", "sub", "/a.txt", "sub/a.txt", false, true},
+		{"Escaped Hash", "", "\\#notes", "#notes", false, true},
+		{"Escaped Space", "", "a\\ ", "a ", false, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			patterns := parseIgnore(test.base, []byte(test.pattern))
+
+			if len(patterns) != 1 {
+				t.Fatalf("parseIgnore returned %d patterns", len(patterns))
+			}
+
+			if m := patterns[0].match(test.rel, test.isDir); m != test.expect {
+				t.Errorf("Did not get expected match for %s got (%v) expected (%v)", test.rel, m, test.expect)
+			}
+		})
+	}
+
+	if patterns := parseIgnore("", []byte("# comment\n\n  \n/\n")); len(patterns) != 0 {
+		t.Errorf("Did not expect patterns got (%v)", patterns)
+	}
+}
+
+func TestIgnoreFiles(t *testing.T) {
+	base, err := ioutil.TempDir("", "ignore-test")
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	files := fstest.MapFS{
+		".embedignore":        &fstest.MapFile{Data: []byte("*.map\n!keep.map\ndrafts/\n/notes.txt\n")},
+		".gitignore":          &fstest.MapFile{Data: []byte("*.log\nkeep.log\n")},
+		"index.html":          &fstest.MapFile{Data: []byte("index")},
+		"notes.txt":           &fstest.MapFile{Data: []byte("notes")},
+		"app.js.map":          &fstest.MapFile{Data: []byte("map")},
+		"keep.map":            &fstest.MapFile{Data: []byte("map")},
+		"debug.log":           &fstest.MapFile{Data: []byte("log")},
+		"drafts/a.html":       &fstest.MapFile{Data: []byte("draft")},
+		"docs/notes.txt":      &fstest.MapFile{Data: []byte("notes")},
+		"docs/.embedignore":   &fstest.MapFile{Data: []byte("!*.map\n*.txt\n!keep.log\n")},
+		"docs/a.map":          &fstest.MapFile{Data: []byte("map")},
+		"docs/keep.log":       &fstest.MapFile{Data: []byte("log")},
+		"docs/drafts/b.html":  &fstest.MapFile{Data: []byte("draft")},
+		"other/debug.log":     &fstest.MapFile{Data: []byte("log")},
+		"other/drafts/c.html": &fstest.MapFile{Data: []byte("draft")},
+	}
+
+	for _, test := range []struct {
+		name   string
+		git    bool
+		expect []string
+	}{
+		{
+			name: "Embed Ignore",
+			expect: []string{
+				"/.gitignore", "/debug.log", "/docs/a.map", "/docs/keep.log",
+				"/index.html", "/keep.map", "/other/debug.log",
+			},
+		},
+		{
+			name: "Git Ignore",
+			git:  true,
+			expect: []string{
+				"/docs/a.map", "/docs/keep.log", "/index.html", "/keep.map",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var gen generate
+
+			config := New()
+			config.Output = filepath.Join(base, "assets", "files")
+			config.GitIgnore = test.git
+			config.Sources = []Source{{FS: files}}
+
+			if err := gen.generate(config); err != nil {
+				t.Fatalf("Generate returned unexpected error %v", err)
+			}
+
+			var names []string
+			for _, f := range gen.Files {
+				names = append(names, f.name)
+			}
+
+			if !reflect.DeepEqual(names, test.expect) {
+				t.Errorf("Did not get expected files got (%v) expected (%v)", names, test.expect)
+			}
+		})
+	}
+}
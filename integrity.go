@@ -0,0 +1,48 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"strings"
+)
+
+// integrityHashes the hash algorithms allowed for subresource integrity
+var integrityHashes = map[string]func() hash.Hash{
+	"sha256": sha256.New,
+	"sha384": sha512.New384,
+	"sha512": sha512.New,
+}
+
+// parseIntegrity parses a comma separated list of subresource integrity hash algorithms
+func parseIntegrity(list string) (algorithms []string, err error) {
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.ToLower(strings.TrimSpace(entry)); len(entry) > 0 {
+			if integrityHashes[entry] == nil {
+				return nil, fmt.Errorf("Integrity %q is not one of sha256, sha384 or sha512", entry)
+			}
+			algorithms = append(algorithms, entry)
+		}
+	}
+
+	return
+}
+
+// integrity returns the subresource integrity metadata of data
+func integrity(algorithms []string, data []byte) string {
+	list := make([]string, len(algorithms))
+
+	for i, algorithm := range algorithms {
+		h := integrityHashes[algorithm]()
+		h.Write(data)
+		list[i] = algorithm + "-" + base64.StdEncoding.EncodeToString(h.Sum(nil))
+	}
+
+	return strings.Join(list, " ")
+}
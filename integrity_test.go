@@ -0,0 +1,47 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIntegrity(t *testing.T) {
+	const script = "alert('Hello, world.');"
+
+	for _, test := range []struct {
+		name       string
+		list       string
+		algorithms []string
+		expect     string
+		hasErr     bool
+	}{
+		{"Empty", "", nil, "", false},
+		{"SHA384", "sha384", []string{"sha384"}, "sha384-H8BRh8j48O9oYatfu5AZzq6A9RINhZO5H16dQZngK7T62em8MUt1FLm52t+eX6xO", false},
+		{"List", " SHA256, sha384 ", []string{"sha256", "sha384"}, "sha256-qznLcsROx4GACP2dm0UCKCzCG+HiZ1guq6ZZDob/Tng= sha384-H8BRh8j48O9oYatfu5AZzq6A9RINhZO5H16dQZngK7T62em8MUt1FLm52t+eX6xO", false},
+		{"Bad", "md5", nil, "", true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			algorithms, err := parseIntegrity(test.list)
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("parseIntegrity did not return an error")
+				}
+			} else if !test.hasErr {
+				t.Errorf("parseIntegrity returned unexpected error %v", err)
+			}
+
+			if !reflect.DeepEqual(algorithms, test.algorithms) {
+				t.Errorf("Did not get expected algorithms got (%v) expected (%v)", algorithms, test.algorithms)
+			}
+
+			if s := integrity(algorithms, []byte(script)); s != test.expect {
+				t.Errorf("Did not get expected integrity got (%s) expected (%s)", s, test.expect)
+			}
+		})
+	}
+}
@@ -10,54 +10,81 @@ import (
 // FS return file system
 var FS embedded.FileSystem
 
-var templatesData [10022]byte
+var templatesData [18049]byte
 
 func init() {
 
 	bytes := templatesData[:]
 	str := *(*string)(unsafe.Pointer(&bytes))
 
-	FS = embedded.New(5)
+	FS = embedded.New(8)
 
-	FS.AddFile( /* /fs.go */ str[10016:10022],
-		/* fs.go */ str[10017:10022],
+	FS.AddFile( /* /digest.go */ str[18015:18025],
+		/* digest.go */ str[18016:18025],
 		"",
-		13033, 1581811583,
-		/* text/plain; charset=utf-8 */ str[9955:9980],
-		/* i9RWy3eZ7n315koQ_hOgslduSLA-gz */ str[9895:9925],
-		true, bytes[0:4037], str[0:4037])
+		1391, 1792319629,
+		/* text/plain; charset=utf-8 */ str[17951:17976],
+		/* kkFuaFbrkglkB-F8pJAyBJa6nvc */ str[17870:17897],
+		true, bytes[0:656], str[0:656])
 
-	FS.AddFile( /* /fs_test.go */ str[9995:10006],
-		/* fs_test.go */ str[9996:10006],
+	FS.AddFile( /* /fs.go */ str[18043:18049],
+		/* fs.go */ str[18044:18049],
 		"",
-		13349, 1581810827,
-		/* text/plain; charset=utf-8 */ str[9955:9980],
-		/* veLuC0ey7xzGI4LKlepQrtVCvQs-gz */ str[9925:9955],
-		true, bytes[4037:7061], str[4037:7061])
+		20260, 1792323411,
+		/* text/plain; charset=utf-8 */ str[17951:17976],
+		/* xbL7qi44DTp-H3gl6l34VJt5KrE */ str[17924:17951],
+		true, bytes[656:6440], str[656:6440])
 
-	FS.AddFile( /* /server.go */ str[10006:10016],
-		/* server.go */ str[10007:10016],
+	FS.AddFile( /* /fs_test.go */ str[18004:18015],
+		/* fs_test.go */ str[18005:18015],
 		"",
-		4705, 1581811858,
-		/* text/plain; charset=utf-8 */ str[9955:9980],
-		/* MBLxmmA4MTo2axIC2LVINsGnwCA-gz */ str[9835:9865],
-		true, bytes[7061:8905], str[7061:8905])
+		19495, 1792322939,
+		/* text/plain; charset=utf-8 */ str[17951:17976],
+		/* 1f6M3Xnxah_Gbn0iRislsleNfnY */ str[17789:17816],
+		true, bytes[6440:10539], str[6440:10539])
 
-	FS.AddFile( /* /server_test.go */ str[9980:9995],
-		/* server_test.go */ str[9981:9995],
+	FS.AddFile( /* /iofs.go */ str[18035:18043],
+		/* iofs.go */ str[18036:18043],
 		"",
-		2722, 1581810843,
-		/* text/plain; charset=utf-8 */ str[9955:9980],
-		/* ZeZNWLAVt5neOZqOvGmHPA2Yngk-gz */ str[9865:9895],
-		true, bytes[8905:9835], str[8905:9835])
+		4481, 1792322931,
+		/* text/plain; charset=utf-8 */ str[17951:17976],
+		/* m-3gyh1R7Tg8vSYGmYy2oLCEE9k */ str[17897:17924],
+		true, bytes[10539:12037], str[10539:12037])
 
-	FS.AddFolder( /* / */ str[9959:9960],
-		/* / */ str[9959:9960],
+	FS.AddFile( /* /iofs_test.go */ str[17991:18004],
+		/* iofs_test.go */ str[17992:18004],
 		"",
-		1581810752,
-		/* /fs.go */ str[10016:10022],
-		/* /fs_test.go */ str[9995:10006],
-		/* /server.go */ str[10006:10016],
-		/* /server_test.go */ str[9980:9995],
+		4008, 1792322939,
+		/* text/plain; charset=utf-8 */ str[17951:17976],
+		/* bM95Z7FgPiu69yZWGnFdty9exVY */ str[17843:17870],
+		true, bytes[12037:13202], str[12037:13202])
+
+	FS.AddFile( /* /server.go */ str[18025:18035],
+		/* server.go */ str[18026:18035],
+		"",
+		7407, 1792323497,
+		/* text/plain; charset=utf-8 */ str[17951:17976],
+		/* -FCigLa5Cra9wchO7Iv5CZxt-9A */ str[17762:17789],
+		true, bytes[13202:15866], str[13202:15866])
+
+	FS.AddFile( /* /server_test.go */ str[17976:17991],
+		/* server_test.go */ str[17977:17991],
+		"",
+		7082, 1792323497,
+		/* text/plain; charset=utf-8 */ str[17951:17976],
+		/* Z3JDO0rG39-Ycj-QWc-wU8pzY64 */ str[17816:17843],
+		true, bytes[15866:17762], str[15866:17762])
+
+	FS.AddFolder( /* / */ str[17976:17977],
+		/* / */ str[17976:17977],
+		"",
+		1792321744,
+		/* /digest.go */ str[18015:18025],
+		/* /fs.go */ str[18043:18049],
+		/* /fs_test.go */ str[18004:18015],
+		/* /iofs.go */ str[18035:18043],
+		/* /iofs_test.go */ str[17991:18004],
+		/* /server.go */ str[18025:18035],
+		/* /server_test.go */ str[17976:17991],
 	)
 }
@@ -2,631 +2,1133 @@
 
 #include "textflag.h"
 
-DATA ·templatesData+0(SB)/16,$"\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x3a\xdf\x8f\xdb\x36"
-DATA ·templatesData+16(SB)/16,$"\x93\xcf\xd2\x5f\x31\xdd\x87\xad\x94\xaa\x72\x7a\x28\xae\x80\x5b"
-DATA ·templatesData+32(SB)/16,$"\x07\x48\xf3\x25\x40\x0e\xd7\xb4\xc8\xe6\xc3\x3d\x04\x8b\x82\x2b"
-DATA ·templatesData+48(SB)/16,$"\x51\x36\xbb\x32\xe9\x23\xe9\x75\xdc\xcd\xfe\xef\x87\x99\x21\x25"
-DATA ·templatesData+64(SB)/16,$"\x4a\x76\xbe\x6c\xfb\xe5\xf6\x21\x91\xa8\x99\xe1\xfc\x9e\xe1\xd0"
-DATA ·templatesData+80(SB)/16,$"\x3b\xd1\xdc\x8a\xb5\x04\xb9\xbd\x91\x6d\x2b\xdb\x3c\x57\xdb\x9d"
-DATA ·templatesData+96(SB)/16,$"\xb1\x1e\x8a\x3c\xbb\xb8\x39\x7a\xe9\x2e\xf2\xec\xa2\x31\xdb\x9d"
-DATA ·templatesData+112(SB)/16,$"\x95\xce\x2d\xd6\x7f\xaa\x1d\x2e\x48\x6b\x8d\xa5\x4f\xca\xf0\xbf"
-DATA ·templatesData+128(SB)/16,$"\x0b\x65\xf6\x5e\xf5\xf8\xa2\xa5\x5f\x6c\xbc\x27\x40\x43\x40\x3b"
-DATA ·templatesData+144(SB)/16,$"\xe1\x37\xf1\xff\x45\xa7\x7a\x19\x17\x9c\xb1\x9e\xfe\xf7\x56\xe9"
-DATA ·templatesData+160(SB)/16,$"\x35\xc1\x7a\xb5\x95\xf8\xff\x5e\x3b\xd1\xc9\x8b\xbc\xcc\xf3\xc5"
-DATA ·templatesData+176(SB)/16,$"\x02\x5e\xa9\x5e\x5e\x1d\x9d\x97\x5b\x68\x65\xa7\xb4\x74\xe0\x37"
-DATA ·templatesData+192(SB)/16,$"\x32\x5d\x56\xda\x4b\xdb\x89\x46\x82\xd0\x2d\xdc\xec\x55\xdf\x4a"
-DATA ·templatesData+208(SB)/16,$"\x9b\xfb\xe3\xee\x13\x50\xf7\x79\x86\x4c\xd6\xe3\xc7\x3c\xcf\x16"
-DATA ·templatesData+224(SB)/16,$"\x0b\xf8\x1f\xd1\xdf\xc2\x41\xf4\xb7\xbc\x03\x72\x0b\xde\x4a\x09"
-DATA ·templatesData+240(SB)/16,$"\xd6\x18\x2f\x5b\x10\x9e\x9e\x2a\x68\x44\xdf\x2b\xbd\x26\xd8\x57"
-DATA ·templatesData+256(SB)/16,$"\x1a\x3a\x63\x41\x8a\x66\xc3\x18\xc6\x12\xb1\x56\x59\xd9\x78\x63"
-DATA ·templatesData+272(SB)/16,$"\x8f\xa0\x34\x91\x43\x4a\x15\x28\xdd\xf4\xfb\x16\x91\x91\x54\x0d"
-DATA ·templatesData+288(SB)/16,$"\xcf\xfb\x1e\x58\xa5\xe0\x37\xc2\x83\xb0\xca\x49\xb8\x53\x4e\x79"
-DATA ·templatesData+304(SB)/16,$"\x04\x42\x8a\x8e\xe8\xa1\x68\x91\xa6\x92\x0e\x84\x25\x0e\xbd\xb4"
-DATA ·templatesData+320(SB)/16,$"\xb2\x85\x9b\x63\xe0\xa5\x86\x77\x81\x73\x86\xc0\x55\xd9\x22\x0b"
-DATA ·templatesData+336(SB)/16,$"\xbd\xfc\xa0\x1a\xd1\x13\x2d\x63\x5b\x69\xeb\x3c\x43\x81\x0b\xe4"
-DATA ·templatesData+352(SB)/16,$"\x03\xd8\x0a\x55\x94\x08\x3f\xbc\xda\xeb\xa6\x64\xde\x58\x3d\x2f"
-DATA ·templatesData+368(SB)/16,$"\xcc\xee\x08\xa2\xef\x03\x79\x6f\xc0\x0b\xbb\x96\x7e\x14\x35\xcf"
-DATA ·templatesData+384(SB)/16,$"\x10\xa6\x08\xcb\x91\xe6\xd6\xb4\x12\x8c\x23\x75\xff\x62\x5a\x39"
-DATA ·templatesData+400(SB)/16,$"\x21\xfa\xbc\x6d\x71\x1d\x44\xdb\x82\x08\x2a\x37\x83\x4f\xf2\x56"
-DATA ·templatesData+416(SB)/16,$"\x6c\xa2\x2c\x80\x16\xe8\x41\x03\x71\x2d\xb6\x72\x78\xe9\x4d\x23"
-DATA ·templatesData+432(SB)/16,$"\xfa\xe1\xcd\xa9\x3f\x25\x5a\xfd\x3f\xbf\x27\x1e\xd0\xbb\x86\x57"
-DATA ·templatesData+448(SB)/16,$"\xb5\x95\xef\xd0\x3f\x22\xac\x17\xeb\xe1\x39\xba\x3c\xea\xd5\x98"
-DATA ·templatesData+464(SB)/16,$"\xbe\x82\x56\x78\x01\xef\xaf\x31\x26\x2a\x84\x0a\x90\x51\x8e\x28"
-DATA ·templatesData+480(SB)/16,$"\x86\x41\xb7\x7b\xac\x20\x04\xfc\x68\x51\x66\xec\x23\x9a\x83\xba"
-DATA ·templatesData+496(SB)/16,$"\xae\xa7\x8c\xb0\x13\x5b\xe5\x25\xa9\xf4\x80\x4f\x8e\xb9\xf7\x26"
-DATA ·templatesData+512(SB)/16,$"\x32\x85\xbb\x90\xc3\xe0\x1b\xbe\xd4\x84\xf6\xba\x1b\x7d\xbe\x35"
-DATA ·templatesData+528(SB)/16,$"\xd2\x81\x36\x1e\xe4\x07\xe5\x7c\x95\x90\x6c\xac\x14\x48\x53\x79"
-DATA ·templatesData+544(SB)/16,$"\x38\x28\xbf\x81\x9d\xb4\x5b\xe5\x9c\x32\xda\xd1\xf3\x8f\xec\x5e"
-DATA ·templatesData+560(SB)/16,$"\x7e\x23\xed\x01\xfd\x78\xc4\xf4\x76\xaf\x9b\x88\x7b\x23\x3b\x63"
-DATA ·templatesData+576(SB)/16,$"\x99\x41\xa5\xd7\xe8\x88\x11\xae\x88\x5c\x0d\xa2\x4f\x94\x8f\x7b"
-DATA ·templatesData+592(SB)/16,$"\x7c\xd2\x95\xfe\xe9\xe4\x7f\x93\xd6\xf6\x4e\x82\xd1\xd0\x2a\x77"
-DATA ·templatesData+608(SB)/16,$"\x0b\x0d\x3a\xad\xd2\xce\x4b\xd1\x82\xe9\x46\x83\x10\xdd\x02\x43"
-DATA ·templatesData+624(SB)/16,$"\xb7\x95\x77\xb2\x37\xbb\xad\xd4\xbe\xcc\xb3\x48\xa5\x40\xdb\x97"
-DATA ·templatesData+640(SB)/16,$"\xf9\x03\xe5\xa0\xab\x5b\xb5\xfb\x87\xb2\xa0\x1c\x12\x6f\x41\x38"
-DATA ·templatesData+656(SB)/16,$"\x10\x60\xa5\xdf\x5b\x0d\x77\xa2\xdf\x4b\xe8\xac\xd9\x0e\x61\x43"
-DATA ·templatesData+672(SB)/16,$"\xc1\xa1\x74\xab\x50\x62\x8a\x6b\x24\x82\xfa\x1d\xb3\x02\xdb\x21"
-DATA ·templatesData+688(SB)/16,$"\xe4\x06\x4c\x28\x48\xdc\x1b\xb8\x91\xe0\x6e\xd5\x6e\x27\xdb\x1a"
-DATA ·templatesData+704(SB)/16,$"\x5e\x7b\x5c\x44\x4b\xf0\x5e\xb2\x45\x3a\xb8\xb9\x66\xc1\xd1\x8e"
-DATA ·templatesData+720(SB)/16,$"\x42\x1f\xa1\xdb\xeb\xc6\x2b\xa3\xeb\xfc\x4e\xd8\x81\xdb\x15\xc4"
-DATA ·templatesData+736(SB)/16,$"\x84\x5b\x87\x25\x12\x26\x72\x49\x1b\x62\x62\xc2\x40\x30\xc1\xfe"
-DATA ·templatesData+752(SB)/16,$"\x81\x10\x71\x84\x6e\x3b\x4b\x6d\x49\xac\x2f\x16\x9c\xa6\xd8\x9b"
-DATA ·templatesData+768(SB)/16,$"\x90\x28\x27\x1f\xf2\x69\x61\xd7\x7b\x54\x28\x34\x46\x7b\xa1\x34"
-DATA ·templatesData+784(SB)/16,$"\xef\x34\xac\x7a\xc3\xc9\x16\x45\x41\x42\x3b\x2b\x3b\xf5\xe1\x47"
-DATA ·templatesData+800(SB)/16,$"\x4e\x81\xca\x55\xa0\x3a\x06\x50\x2e\x72\x42\xfe\x76\xd1\x2a\x7b"
-DATA ·templatesData+816(SB)/16,$"\x51\xc1\x61\xa3\x9a\x0d\x7e\x13\x53\x7e\xc2\x66\x98\x38\x07\x67"
-DATA ·templatesData+832(SB)/16,$"\xbe\x10\x17\x15\xbd\x61\x6a\x1b\xe5\x3b\xa8\xbe\x47\x5d\xa7\xd4"
-DATA ·templatesData+848(SB)/16,$"\x23\x7b\x48\x0a\x77\x5a\x88\x0b\x16\x49\xe9\xce\x8c\xcc\x07\xb5"
-DATA ·templatesData+864(SB)/16,$"\x05\x37\x7c\x8d\xdf\x50\x4d\xb8\xc6\x46\x25\x8d\xe7\x8b\x45\x3e"
-DATA ·templatesData+880(SB)/16,$"\xc4\x15\x65\x62\x64\x77\x67\xcd\x4d\x2f\xb7\xc4\x0c\xb1\x69\x46"
-DATA ·templatesData+896(SB)/16,$"\x4e\x53\xed\x8e\x61\x8a\xc4\x48\x00\xa4\xa6\x74\x63\xb6\x88\xc7"
-DATA ·templatesData+912(SB)/16,$"\xd6\x27\x21\x5a\xe9\x1a\xab\x6e\x24\x11\x8a\xf4\xb1\x5a\xcc\xec"
-DATA ·templatesData+928(SB)/16,$"\xa9\xa1\x95\x8d\x6a\x25\x6c\xcc\x81\xdc\xd1\xc0\x46\xe8\xb6\x67"
-DATA ·templatesData+944(SB)/16,$"\x07\x0d\x14\x0b\x44\xe4\x3a\x88\xb4\xd1\xf5\x90\xbe\xd4\xe8\xaa"
-DATA ·templatesData+960(SB)/16,$"\xc4\xac\x48\xf2\x7d\x59\xc3\x6b\x1d\x79\x6b\x84\x23\x37\x8a\xbe"
-DATA ·templatesData+976(SB)/16,$"\xc9\x5a\x9f\xaa\x2e\x6a\x5d\xab\xbe\x46\xd5\x0c\x7e\xac\xdc\xe0"
-DATA ·templatesData+992(SB)/16,$"\xe2\x15\x3b\x84\x69\xa4\x73\x28\xaa\xf3\x66\xe7\xd8\x0e\xce\xf4"
-DATA ·templatesData+1008(SB)/16,$"\x12\xe4\x87\x46\xee\x48\x26\xe5\xe0\xb0\x91\x7a\x2a\x28\x93\x61"
-DATA ·templatesData+1024(SB)/16,$"\x13\xb9\x9d\x6c\x94\xe8\xc9\x55\x29\x4a\x43\x18\xd4\x43\xba\x9b"
-DATA ·templatesData+1040(SB)/16,$"\x63\xc5\xd0\x21\xba\x4a\xdf\x19\xac\x9e\x46\xa7\x8e\x56\xc5\x18"
-DATA ·templatesData+1056(SB)/16,$"\xa2\x38\x75\xd3\xb0\xfe\xda\x91\x13\x4a\xed\x1d\x48\xed\x95\x95"
-DATA ·templatesData+1072(SB)/16,$"\xfd\xf1\xb3\xbb\x21\xc1\xd3\x0d\xb5\xd1\xdf\x8e\xfe\x80\x1e\x52"
-DATA ·templatesData+1088(SB)/16,$"\xcd\xb7\xb5\x72\x1b\xdc\x9d\x4b\xb2\x1a\x8d\x31\x46\xc2\x40\xa3"
-DATA ·templatesData+1104(SB)/16,$"\xe6\x96\x68\x08\x7f\x64\x67\x5a\x81\xc8\x58\xd1\xa9\x2b\x34\x0d"
-DATA ·templatesData+1120(SB)/16,$"\x9b\x67\x48\xb3\xa1\x1d\x23\x9f\xa7\x7e\x4a\x0b\x6e\x07\x82\x9d"
-DATA ·templatesData+1136(SB)/16,$"\x35\x17\xba\x41\x07\x63\x13\x36\xa2\xc4\x16\x2c\x89\x1f\xec\x1b"
-DATA ·templatesData+1152(SB)/16,$"\x62\xcd\x2d\x4a\xaa\xba\x80\xb1\x83\x52\x2a\x17\x49\x46\x88\x3c"
-DATA ·templatesData+1168(SB)/16,$"\x7b\x27\xd6\x45\x19\xb8\x06\xfa\x5b\x2c\xe0\x25\xd6\xf0\x18\x88"
-DATA ·templatesData+1184(SB)/16,$"\x53\x2e\xb2\x5f\x42\xbd\x1f\xb1\x16\x0b\xc0\x45\xe2\x0f\x91\x66"
-DATA ·templatesData+1200(SB)/16,$"\x08\x57\x04\x95\x6e\xb2\x58\x4c\x61\x30\x77\xf1\xc7\x3c\xfb\x19"
-DATA ·templatesData+1216(SB)/16,$"\xbb\xe5\xa2\x0c\x65\x0a\x3e\x01\x4d\xdf\x84\xb5\xe2\x98\x67\x6f"
-DATA ·templatesData+1232(SB)/16,$"\xc5\x61\x02\x4f\x18\x56\x1c\x08\x28\x88\xad\xc8\xa0\x56\x8a\xd6"
-DATA ·templatesData+1248(SB)/16,$"\xe8\xfe\x08\x5b\xb9\xc5\x34\xf7\x90\xb3\x52\x89\xbc\xf3\x76\xdf"
-DATA ·templatesData+1264(SB)/16,$"\x78\xd4\x26\xd5\x4c\xfe\x8b\x5c\x51\xff\xc3\x7f\xd4\x37\xe4\x59"
-DATA ·templatesData+1280(SB)/16,$"\x6c\x23\xc6\x15\x6e\x33\x26\x68\xca\x61\x00\xd0\x1f\x1a\x22\xcf"
-DATA ·templatesData+1296(SB)/16,$"\x66\xfd\x50\x9e\x0d\xdd\xd3\x88\x84\xba\x9f\x6d\x4f\x05\x96\xff"
-DATA ·templatesData+1312(SB)/16,$"\x58\xce\x3c\xc3\xde\x69\xce\xe4\xfe\xe6\x15\xf9\x2e\x42\x0d\xfe"
-DATA ·templatesData+1328(SB)/16,$"\x90\x0a\xe9\x12\x29\x7b\xe5\x3c\xe3\x6f\xc5\xee\x3d\xd3\xb8\x7e"
-DATA ·templatesData+1344(SB)/16,$"\x82\x50\xa9\x28\xcc\xa5\x18\x5a\x32\x7a\xe7\x42\xfe\x46\x1e\x86"
-DATA ·templatesData+1360(SB)/16,$"\x26\x46\x80\x96\x87\xf4\x84\x40\xc9\xad\x37\xa2\x75\x63\x9b\x10"
-DATA ·templatesData+1376(SB)/16,$"\x0c\x58\xe7\x18\x2d\x88\x5e\x34\x66\x8f\x45\x40\xfb\x32\xc5\xbd"
-DATA ·templatesData+1392(SB)/16,$"\xcf\xb3\xd0\x0f\x5c\x12\xd3\xf7\xc8\xea\x12\xb6\xe2\x56\x16\x73"
-DATA ·templatesData+1408(SB)/16,$"\x5e\xb1\xc5\xdc\x6b\x5f\x3e\x20\x53\x44\xb7\xe8\x1c\xd0\x27\x57"
-DATA ·templatesData+1424(SB)/16,$"\xc2\xaf\x3b\xa9\x8b\xa4\x05\x2a\x81\x9a\x22\x18\x8e\x2c\x93\xc0"
-DATA ·templatesData+1440(SB)/16,$"\xbc\xcf\x33\xd5\x41\x57\x81\xb9\x85\xe5\x0a\x3a\x57\xe3\xc6\xef"
-DATA ·templatesData+1456(SB)/16,$"\x11\xff\xfa\x47\x5c\xbc\xcf\x33\x82\x70\x35\x2b\xe8\xf2\x12\x7a"
-DATA ·templatesData+1472(SB)/16,$"\xa9\x8b\x8e\x5f\x4b\x78\x06\x4f\x09\x26\xeb\x06\xd2\x2b\xac\x6d"
-DATA ·templatesData+1488(SB)/16,$"\xc4\x46\x84\xca\xb3\xec\x01\x64\xef\xe4\x08\x0a\x2b\xb8\x44\xdf"
-DATA ·templatesData+1504(SB)/16,$"\x94\xf6\x1e\x5f\x97\xc8\x43\x2f\xf5\xda\x6f\x96\xd0\xd5\xe8\x7b"
-DATA ·templatesData+1520(SB)/16,$"\x0f\x88\x95\xa7\x88\x03\xf1\x97\xd6\xbe\x31\xfe\x25\xb6\x97\x39"
-DATA ·templatesData+1536(SB)/16,$"\xc2\xb0\xea\x82\x8d\xa8\x50\x5b\xd9\xec\xad\x53\x77\xb2\x3f\xc6"
-DATA ·templatesData+1552(SB)/16,$"\x0a\xe4\x42\x2d\x9c\x9e\xbe\xea\x53\x0d\xe2\x87\xa2\xfb\x57\x99"
-DATA ·templatesData+1568(SB)/16,$"\xed\xe4\x98\x53\x9c\xa8\xf4\x2b\x44\xa9\x5f\x63\x30\x14\xb4\x16"
-DATA ·templatesData+1584(SB)/16,$"\xed\xcb\xb8\x4c\x9f\x09\x57\x58\xcf\x4a\x14\x24\xcf\x3a\xb4\x02"
-DATA ·templatesData+1600(SB)/16,$"\xa1\x16\xc4\x4e\x99\xa3\xd4\xdf\xe1\xea\x39\x44\x69\x6d\x19\xfb"
-DATA ·templatesData+1616(SB)/16,$"\x6f\x64\xe1\xab\x15\xd2\x62\x06\xb1\x66\x7f\xed\xf9\x31\x14\x5f"
-DATA ·templatesData+1632(SB)/16,$"\xe5\xd2\x74\x8e\x78\x44\x9c\xb1\x60\x2b\x85\x76\x51\xb6\x83\xd0"
-DATA ·templatesData+1648(SB)/16,$"\x01\xd7\x1b\x2a\x19\x33\x74\x6c\x37\xb0\xb4\xc6\x56\x84\xc9\xbd"
-DATA ·templatesData+1664(SB)/16,$"\xc3\x5e\x05\x9b\x73\x6a\xc1\x8c\xa6\x8a\x8e\x8c\x61\x6a\xa7\xbd"
-DATA ·templatesData+1680(SB)/16,$"\x94\x43\xa6\x46\x26\xa9\xa4\xb3\x6a\x6a\x52\xdc\x28\x06\x7c\xfc"
-DATA ·templatesData+1696(SB)/16,$"\x38\xe1\x0f\x95\xc8\x7b\x70\xdb\x65\xbf\x76\x70\x23\x37\xe2\x4e"
-DATA ·templatesData+1712(SB)/16,$"\x71\x07\x80\xb1\x66\x0d\xf5\x63\x37\xc7\x50\xe0\xc6\x16\x3b\x69"
-DATA ·templatesData+1728(SB)/16,$"\xfb\xb8\x91\x69\x99\x5c\x72\xf6\x0d\xa2\x6f\xc5\x11\xd4\x5a\xe3"
-DATA ·templatesData+1744(SB)/16,$"\x11\x23\xb2\x1e\x08\x61\xdf\xc1\x58\xaf\xbb\x08\x3d\x2b\xc6\x15"
-DATA ·templatesData+1760(SB)/16,$"\x1f\x6e\xb8\x4f\xe1\x06\x69\x60\x87\xb9\x0e\x14\xae\x0c\x2b\xc0"
-DATA ·templatesData+1776(SB)/16,$"\x6d\xcc\xbe\x1f\x76\x38\x6c\x84\x97\x77\xd2\xce\xa8\xd7\xa3\xff"
-DATA ·templatesData+1792(SB)/16,$"\xa0\x46\x82\xaf\x18\x0b\xbf\x57\xd8\x27\xd8\x23\x3a\x88\x15\x7a"
-DATA ·templatesData+1808(SB)/16,$"\x2d\x31\x78\x62\x4e\x44\x85\x0d\x07\xa2\xe5\x8a\x1b\xcb\xff\x32"
-DATA ·templatesData+1824(SB)/16,$"\x6a\x70\x22\x42\xad\xdf\x88\xad\x2c\xca\x32\x00\x53\xa5\x5d\xae"
-DATA ·templatesData+1840(SB)/16,$"\xc2\xb7\xc1\x0b\x43\xf0\x75\xae\xe6\xf0\x08\x64\x2b\xe8\x66\x51"
-DATA ·templatesData+1856(SB)/16,$"\x51\x72\xc2\x48\xcc\x48\x31\x8f\x21\x11\x41\x87\xb0\x60\x03\x23"
-DATA ·templatesData+1872(SB)/16,$"\x5c\x6c\x9c\x08\x36\xc6\x73\x46\x09\x80\x92\x40\x3e\x0b\xf2\x2f"
-DATA ·templatesData+1888(SB)/16,$"\x36\x6b\xf9\xc2\xa3\x96\x2f\x38\x69\x19\x07\x2d\x27\x19\xea\x11"
-DATA ·templatesData+1904(SB)/16,$"\x83\x97\xd3\x8c\xc4\x19\x63\x9a\xe7\x91\xc6\xf5\x8f\xf0\x55\x48"
-DATA ·templatesData+1920(SB)/16,$"\xf4\x6c\xe3\x90\x68\x58\x7f\x14\xa9\xd3\x94\x5b\x9e\x49\xca\xd1"
-DATA ·templatesData+1936(SB)/16,$"\x2f\x18\x49\x4d\xfd\xe1\x61\x08\xec\xd5\xd4\xd2\x8c\xab\x55\x3f"
-DATA ·templatesData+1952(SB)/16,$"\xb7\xf0\x89\xc4\x7f\x61\x30\x84\x94\xe9\xd3\x8a\x21\x2e\xe1\xe9"
-DATA ·templatesData+1968(SB)/16,$"\x0f\x3f\xfc\x90\x67\xad\xb2\xf4\xbe\xa4\x12\x82\x08\xc8\xc6\x47"
-DATA ·templatesData+1984(SB)/16,$"\x28\x8a\x08\xf6\xfd\xf7\xdf\x97\xf0\xec\x19\xfc\x47\x09\x1f\x09"
-DATA ·templatesData+2000(SB)/16,$"\x77\x28\xca\x9d\xab\x49\xe7\x17\x8b\x8b\xea\xaf\x37\xbe\x24\x2b"
-DATA ·templatesData+2016(SB)/16,$"\x33\xff\x1b\xa2\x2d\x93\x93\x35\x85\x23\x7f\xe3\xc1\x4c\x88\x9f"
-DATA ·templatesData+2032(SB)/16,$"\x93\xf2\x11\x59\x41\xde\x6f\x5b\x65\x9f\xf7\x7d\x31\xd2\xac\x20"
-DATA ·templatesData+2048(SB)/16,$"\x88\x47\xb5\x36\xcf\xd3\x7a\xcc\xf6\xa6\x82\x9c\x6c\x10\xcc\x31"
-DATA ·templatesData+2064(SB)/16,$"\x04\x68\x2b\x3b\xc9\x7d\x6c\xfd\xa2\x37\x4e\x16\x08\x97\x70\x8d"
-DATA ·templatesData+2080(SB)/16,$"\xca\x4a\x19\x47\xce\xc6\xaf\x04\x3c\xd4\xe7\x33\x0c\x52\x6a\x9c"
-DATA ·templatesData+2096(SB)/16,$"\xf3\x78\x27\x2c\x98\xbd\x87\x27\xc1\x8a\xe7\x39\x33\x7b\x9f\x34"
-DATA ·templatesData+2112(SB)/16,$"\x16\x2f\xa8\xfd\x9a\x6d\x4d\xd4\xce\xa0\xfe\x1e\x11\x95\xa9\xc9"
-DATA ·templatesData+2128(SB)/16,$"\x85\x88\x16\xa7\xb4\x40\x7a\x90\x36\x64\xa9\x39\x09\x3c\x68\xbc"
-DATA ·templatesData+2144(SB)/16,$"\xd8\x60\xdf\xeb\x26\xfa\x26\x03\xfd\x62\xda\x77\x0a\xb3\xe7\xfc"
-DATA ·templatesData+2160(SB)/16,$"\xbd\x1c\x51\xb7\xa6\x9d\x20\x46\x0d\x84\xdc\x36\xc9\xec\x79\xf6"
-DATA ·templatesData+2176(SB)/16,$"\x10\x67\x46\x71\xb2\xf9\xbc\x6d\x5d\x32\x11\x1c\xb2\x1d\x4f\x03"
-DATA ·templatesData+2192(SB)/16,$"\xa9\xce\x72\x05\x10\x3d\xf6\x53\x47\x9e\xba\xc5\xf3\xf0\x70\x44"
-DATA ·templatesData+2208(SB)/16,$"\x3e\x0d\xa9\x7f\x63\x1e\x3a\x1e\x05\xbe\xec\x40\x74\x9a\xb4\x28"
-DATA ·templatesData+2224(SB)/16,$"\x6b\xfc\x3e\x4f\x59\xc8\xee\xd8\x9a\x8e\x41\xf1\xd2\xda\xa1\x21"
-DATA ·templatesData+2240(SB)/16,$"\xcc\xb3\x09\x34\xf6\x9a\x28\x34\x22\xa0\x80\xcb\x70\x88\xa0\x02"
-DATA ·templatesData+2256(SB)/16,$"\x96\x67\xdc\xfa\x87\x45\x7a\xc6\x45\x14\x37\x02\xe2\x33\xae\x05"
-DATA ·templatesData+2272(SB)/16,$"\xa9\x69\x39\x3c\xd3\x72\x90\x1e\xd7\xe3\x73\x45\x21\xbf\x8e\x14"
-DATA ·templatesData+2288(SB)/16,$"\x50\x21\xb8\x34\xea\x62\x99\xe8\x05\xbf\xa0\x52\x22\x34\x3e\x13"
-DATA ·templatesData+2304(SB)/16,$"\x0f\xde\x2e\x93\x13\x4f\xc5\xb2\x71\x3b\x3e\x9e\x4f\x42\x5f\xf4"
-DATA ·templatesData+2320(SB)/16,$"\xc6\x1c\x68\x7a\xdc\xf1\x2a\x3a\x05\x95\x1f\x74\x85\xff\xdd\x2b"
-DATA ·templatesData+2336(SB)/16,$"\x4b\x0d\x4f\x74\xf0\x40\xe1\x9d\x49\x26\xc9\xe5\x8f\x27\x55\xbb"
-DATA ·templatesData+2352(SB)/16,$"\x95\xbd\xf4\xb2\x08\xda\x1c\x13\xd4\xb9\x92\x3c\xce\xb0\xa3\xcb"
-DATA ·templatesData+2368(SB)/16,$"\xf2\xdb\x17\x77\xda\xff\x87\xd9\x77\x28\x91\x7f\xdf\xd9\x46\x6b"
-DATA ·templatesData+2384(SB)/16,$"\xac\xc0\xdb\xbd\xcc\x93\xa3\xe9\x72\xc5\xe7\xb8\xf1\x80\x4a\x47"
-DATA ·templatesData+2400(SB)/16,$"\x1d\x92\xc0\x95\x65\xe8\xe4\x54\x05\x72\xec\xe2\x98\x4d\xdc\x34"
-DATA ·templatesData+2416(SB)/16,$"\x92\x79\xaf\xae\x61\x64\x4c\x5e\x3f\xda\xcf\x4f\xbd\x7c\xf0\x71"
-DATA ·templatesData+2432(SB)/16,$"\x3a\xab\xd3\x12\xf2\x3c\xf5\xf0\xc4\xbf\x23\x0b\x4b\x88\x4f\x55"
-DATA ·templatesData+2448(SB)/16,$"\x6a\x7f\x2a\xe0\xe7\x8a\x76\xea\x60\xb3\xc1\xfc\x49\x83\x12\x9c"
-DATA ·templatesData+2464(SB)/16,$"\x25\x76\xa9\x58\x5d\x22\x4a\x99\x9f\x3f\xa4\x32\x4a\xd2\xbe\xcc"
-DATA ·templatesData+2480(SB)/16,$"\x3e\xa4\xea\x18\xf5\x41\xe4\x7f\x16\x4e\x16\x0c\x56\x56\x94\xf8"
-DATA ·templatesData+2496(SB)/16,$"\xa3\x26\xa2\x22\x46\x4d\xe0\xbf\xf5\x1b\x73\x28\xca\xfa\x9f\x5a"
-DATA ·templatesData+2512(SB)/16,$"\x7d\x28\x08\xe1\x21\xed\x7c\x26\x72\x32\xd1\xf3\x4d\xf0\x3c\x9c"
-DATA ·templatesData+2528(SB)/16,$"\x46\xe0\xe9\x41\x97\x9a\xe5\x9a\x27\x29\xf7\x63\x65\xbd\x34\xae"
-DATA ·templatesData+2544(SB)/16,$"\xc6\x1a\xf2\x12\xb5\x76\xff\xeb\x6e\x09\x17\xdb\x5b\x1e\x69\xe3"
-DATA ·templatesData+2560(SB)/16,$"\xf2\x32\xd0\xab\xe0\xa5\xb5\xcb\xe0\xa6\xaf\xf5\x9d\xe8\x55\x9b"
-DATA ·templatesData+2576(SB)/16,$"\xf4\xd1\xa7\x35\xae\x3b\xa3\x55\x5c\x0e\x47\xfe\x15\x5c\x5c\xd0"
-DATA ·templatesData+2592(SB)/16,$"\xeb\xe0\xd1\x2b\x10\xbb\x9d\xd4\x6d\x31\xae\x55\x23\x81\x60\xb6"
-DATA ·templatesData+2608(SB)/16,$"\xeb\xa0\x04\x1e\x17\x44\xb8\x12\x9e\xc1\x77\x2c\x94\x33\xd6\xd7"
-DATA ·templatesData+2624(SB)/16,$"\x57\xbd\x6a\xe4\x94\x0e\xb6\x57\xaa\x82\x3f\x78\x48\x42\xd3\xbc"
-DATA ·templatesData+2640(SB)/16,$"\xf4\x58\x10\x3c\xc8\xd5\x2f\xcc\x76\x27\x6c\x8a\xfc\x5e\x5d\x87"
-DATA ·templatesData+2656(SB)/16,$"\xd3\x4c\x95\x9c\x83\xde\xff\x11\x57\x4b\x94\xfa\xdb\xef\xa8\xf8"
-DATA ·templatesData+2672(SB)/16,$"\x9e\x4d\x63\xa7\x9d\xf6\xdf\xbe\x59\x9a\xd7\xb2\xc5\x02\x7e\x11"
-DATA ·templatesData+2688(SB)/16,$"\xb7\x12\x04\xdf\x29\x85\x0b\x13\x1a\xe0\x21\xa5\x38\x80\x1a\x93"
-DATA ·templatesData+2704(SB)/16,$"\x05\x13\x46\xed\xe1\x77\xec\x28\x10\xb1\xe0\xe0\x25\x9c\x32\x20"
-DATA ·templatesData+2720(SB)/16,$"\x5d\x79\x0a\x9c\x27\xc5\x93\x10\x5c\x05\x5f\x81\xd7\xbf\x19\x1a"
-DATA ·templatesData+2736(SB)/16,$"\x99\x16\x97\x3c\x86\x29\xf3\x38\x2b\xa0\x64\x1c\x72\xee\x1f\x7b"
-DATA ·templatesData+2752(SB)/16,$"\xe7\xc1\xca\x5d\x2f\x1a\xbe\x04\x60\x76\xce\x46\x5c\x34\xed\x90"
-DATA ·templatesData+2768(SB)/16,$"\x12\xbb\x1a\x2b\xfd\xe0\x21\x43\x1f\x30\xfa\x0c\x76\x0d\x2b\xce"
-DATA ·templatesData+2784(SB)/16,$"\xbe\x05\x0a\x13\x59\xc1\x8f\x49\x67\xb0\x82\x4e\xf4\x4e\xd2\x32"
-DATA ·templatesData+2800(SB)/16,$"\x69\x76\xc5\x69\x8a\x89\x78\x1b\xdf\xaf\xbc\x9d\x84\xca\x09\x6b"
-DATA ·templatesData+2816(SB)/16,$"\x9f\x8d\xfa\x98\x55\x28\xca\x87\x2a\x7f\xc2\xe0\x23\x92\xc0\x58"
-DATA ·templatesData+2832(SB)/16,$"\xb3\x87\x8c\x3a\x96\xec\xc8\x6d\x15\xbb\xbc\x7f\xb7\x44\x0f\x8c"
-DATA ·templatesData+2848(SB)/16,$"\x7f\xbe\x4c\x8f\x99\xf3\x31\x3e\x3e\x5c\x59\xf2\x25\x07\x5d\x5c"
-DATA ·templatesData+2864(SB)/16,$"\x52\x4a\x76\x43\x02\xa0\x2f\x71\x06\x8a\x41\x40\x91\x60\xc6\x4b"
-DATA ·templatesData+2880(SB)/16,$"\xdf\x48\x95\x89\x96\xc0\xf1\x16\xc7\xdf\xe3\x74\xb3\xab\x11\x33"
-DATA ·templatesData+2896(SB)/16,$"\x5e\x8b\xa2\x6f\xf0\xd0\x0f\x0f\xbe\x3c\xbb\xc6\x5a\x68\xe5\x7a"
-DATA ·templatesData+2912(SB)/16,$"\xdf\x0b\x1b\xce\xd4\x33\xd2\x88\x55\x94\x6c\xb0\x09\x65\xb4\x65"
-DATA ·templatesData+2928(SB)/16,$"\xa0\x8c\x21\xc8\x4e\x4e\x67\xbb\x1b\xe5\x4f\xc8\x20\x48\x51\xa6"
-DATA ·templatesData+2944(SB)/16,$"\x31\x1b\x87\xa1\x49\xe2\x4d\xce\x5c\xa6\x95\xbf\x61\x90\x7f\x4c"
-DATA ·templatesData+2960(SB)/16,$"\x4e\x8f\xc9\xf0\x31\x85\x19\x99\xc0\xc3\xc0\xc0\x87\xea\x54\x23"
-DATA ·templatesData+2976(SB)/16,$"\xe8\x02\x07\xbd\xe9\x0c\x3f\x7c\x74\x60\x5f\x23\xcc\x51\x3a\x5a"
-DATA ·templatesData+2992(SB)/16,$"\x23\xcf\xeb\xea\x58\x99\xe1\x69\x3c\x2b\xd0\x39\x11\xc4\xcd\x8d"
-DATA ·templatesData+3008(SB)/16,$"\x95\x77\x8a\xb7\x40\x35\xb2\x88\xf1\x14\x39\xdf\x30\x1e\x2e\x63"
-DATA ·templatesData+3024(SB)/16,$"\x82\x1d\xf4\x48\xe2\x47\x13\x1d\x1d\xec\x75\x2b\x6d\x7f\xa4\xcb"
-DATA ·templatesData+3040(SB)/16,$"\x20\x8c\x4c\x67\xf6\xb6\x91\x50\x34\x42\x27\x83\xb1\x13\xfa\x57"
-DATA ·templatesData+3056(SB)/16,$"\x47\xc7\x66\xe2\x3b\x9b\xfb\x87\x74\x93\xc4\x11\x23\xfc\xe9\x1d"
-DATA ·templatesData+3072(SB)/16,$"\x4e\xca\x54\x72\x7f\x73\x8a\x3a\xb9\xd0\x49\xb1\xbc\x58\x9f\x01"
-DATA ·templatesData+3088(SB)/16,$"\x3f\xbd\xce\x49\x71\x62\x0e\x8b\x1a\x60\x88\x38\xe6\x2b\xf6\x3a"
-DATA ·templatesData+3104(SB)/16,$"\xe9\xdb\xb1\x91\xd5\xb2\x91\xce\x09\x7b\x2c\xe3\xb4\x1f\x03\x83"
-DATA ·templatesData+3120(SB)/16,$"\x8c\x4e\x77\xb6\xe1\xaa\x62\xae\x9d\x78\x43\x54\xa4\x07\xa0\x7b"
-DATA ·templatesData+3136(SB)/16,$"\xbe\xe4\x58\x01\xe5\xbc\x3c\x34\x02\xd1\x52\x97\x97\x13\x45\x90"
-DATA ·templatesData+3152(SB)/16,$"\x87\xe2\x11\x3a\xfc\x64\x69\x28\x8c\x3f\x87\x9f\x30\x65\xd9\x5e"
-DATA ·templatesData+3168(SB)/16,$"\xaf\xff\x54\xbb\x0a\x7e\xc7\x2c\x8e\x8f\xf5\x1b\x79\x78\x4b\x63"
-DATA ·templatesData+3184(SB)/16,$"\xf7\x82\xc2\x2d\x79\xe7\xbc\x4b\x99\x39\x9e\x96\x2f\x03\xe5\x0a"
-DATA ·templatesData+3200(SB)/16,$"\x98\x50\x39\x90\x4c\x66\x04\xcc\x70\x80\xac\xa3\x5c\xa7\x73\x79"
-DATA ·templatesData+3216(SB)/16,$"\xba\xe5\x3a\xaf\xc7\x4f\x68\x2e\xdc\xfb\xcc\x34\x17\x6f\xcb\x8a"
-DATA ·templatesData+3232(SB)/16,$"\x9b\x7d\x17\x40\x86\xa1\x7b\x37\x19\x99\x50\x30\xcf\xf4\xf5\x77"
-DATA ·templatesData+3248(SB)/16,$"\x55\x92\xdd\xec\x3b\x44\x5a\x01\xff\x5c\xad\x46\x90\xe7\x7d\x5f"
-DATA ·templatesData+3264(SB)/16,$"\x8c\x9a\x39\x55\x4d\x7a\xe9\x81\xdc\x9e\xa9\xed\xe9\x16\x54\xdf"
-DATA ·templatesData+3280(SB)/16,$"\x69\x9f\xb0\x1a\xd3\xf7\x54\x91\x6f\x1f\x73\xcf\x37\x53\xda\xe4"
-DATA ·templatesData+3296(SB)/16,$"\xc2\x30\xf5\x76\x2a\xf6\xf1\xbe\x8c\x2f\x64\x92\x0b\xb3\x70\x37"
-DATA ·templatesData+3312(SB)/16,$"\xd6\xa0\x44\x6d\x3c\x89\x86\x0b\xb2\x9d\x71\x8a\x52\xcd\xe4\x8e"
-DATA ·templatesData+3328(SB)/16,$"\xd0\x49\x79\x0b\xe3\x5f\xbc\x27\xe4\x24\x3f\x5b\xc5\xed\x5e\xcc"
-DATA ·templatesData+3344(SB)/16,$"\x6f\x07\x5b\x19\x2d\x66\x2c\x00\x3c\x21\x95\xb2\x3d\xf2\x0c\xd9"
-DATA ·templatesData+3360(SB)/16,$"\xe7\x67\xa2\xf3\x84\x0d\x16\xbe\x0e\x81\x6e\xe1\x09\x4b\x52\x42"
-DATA ·templatesData+3376(SB)/16,$"\xb0\xc5\x99\x71\xa8\xad\x83\x54\xf3\x9b\xa5\xd0\x31\x4f\xba\x8c"
-DATA ·templatesData+3392(SB)/16,$"\x01\x38\x9c\xee\x32\xa6\x30\xe1\x35\x2d\xc8\x4c\x6f\x0a\x90\x8e"
-DATA ·templatesData+3408(SB)/16,$"\xd5\x66\xa8\x61\x06\x4a\xa7\x0a\x5b\x27\x42\x0e\xc3\xd1\x93\xfa"
-DATA ·templatesData+3424(SB)/16,$"\x9d\xc8\x78\xe5\x85\x47\x11\x69\x0a\x99\x5c\x8b\x9f\xb9\xe8\xfb"
-DATA ·templatesData+3440(SB)/16,$"\x4b\x42\x13\xbd\x15\xd8\xcf\xed\x8f\xbc\xb6\xca\xa6\x37\x9b\x05"
-DATA ·templatesData+3456(SB)/16,$"\xdd\xb4\xbe\xbf\xfe\x92\xdc\x20\x7c\x72\x24\xea\xf9\xa6\x2c\x76"
-DATA ·templatesData+3472(SB)/16,$"\x6d\x76\x3c\x61\x94\x61\x92\x67\xeb\xc1\x45\x9f\xad\x80\xae\x2e"
-DATA ·templatesData+3488(SB)/16,$"\x99\xc5\xe1\xce\x32\x1b\x86\x83\x2f\x7f\x7d\x45\xa7\x82\x24\x60"
-DATA ·templatesData+3504(SB)/16,$"\x91\x04\xc3\xff\xb4\x82\xa7\xf0\xf1\x63\xd8\x8d\x2f\x5f\xe1\x19"
-DATA ·templatesData+3520(SB)/16,$"\xf4\xdf\x26\x3b\x30\x4a\xc6\x08\xc4\x58\xd1\xc3\xb7\x09\x0f\x3c"
-DATA ·templatesData+3536(SB)/16,$"\x11\x7c\xe0\x6d\x43\xe3\x87\xc7\xfd\xf1\x98\x92\x50\x5b\x26\x88"
-DATA ·templatesData+3552(SB)/16,$"\xdf\xa4\xdb\x5e\xf3\x41\x68\x84\xfc\x66\x35\x61\x8b\x3e\x93\xf2"
-DATA ·templatesData+3568(SB)/16,$"\x87\x5c\x33\xb1\x01\xaa\x2a\xec\x1e\x66\x94\xa7\xb3\x87\xc8\x5d"
-DATA ·templatesData+3584(SB)/16,$"\x10\x89\xda\x6b\x1a\x3e\xc8\x51\x84\x87\x59\x76\x63\x45\xf2\xf5"
-DATA ·templatesData+3600(SB)/16,$"\x08\x26\xd0\xe2\x82\xbd\xa3\x0e\xce\xb1\xa4\xdf\x02\x91\x55\x01"
-DATA ·templatesData+3616(SB)/16,$"\xbb\x13\xd5\xcb\x8b\x73\xe9\xed\xd4\xb9\xa5\xbc\x2d\x4c\xd7\x39"
-DATA ·templatesData+3632(SB)/16,$"\xe9\xe3\x08\xe7\xb0\x91\xba\x91\xc1\xd1\x74\x5c\xfd\xb2\xde\xa5"
-DATA ·templatesData+3648(SB)/16,$"\x3a\x08\x9b\xae\xd0\xf8\x97\x97\x71\xd7\x15\xb9\x0b\x72\x75\xe5"
-DATA ·templatesData+3664(SB)/16,$"\x85\xf5\xf1\x64\x3a\x1a\x64\x05\x4f\x4f\x3c\xe9\x93\xda\x41\x3a"
-DATA ·templatesData+3680(SB)/16,$"\x4b\x50\xcc\x10\xc9\xca\xbf\x3c\x0c\x17\x52\x17\xe5\x39\x5d\xbb"
-DATA ·templatesData+3696(SB)/16,$"\x83\xf2\xcd\x26\x32\x44\x4b\xf4\xe3\xa9\x94\xaf\x25\xed\x4b\xdc"
-DATA ·templatesData+3712(SB)/16,$"\xc0\x37\x41\x94\x39\xe0\x8b\xbd\xb5\x52\x27\xa0\xec\x48\xb6\xc6"
-DATA ·templatesData+3728(SB)/16,$"\x1c\x5e\x7e\x12\xed\xa5\x6e\x47\x14\x5b\x87\xd4\x9e\x42\xb7\xb2"
-DATA ·templatesData+3744(SB)/16,$"\x13\xfb\x3e\x10\x0e\x95\xe6\x69\xf5\x79\xf9\x59\xa2\x28\x74\x30"
-DATA ·templatesData+3760(SB)/16,$"\x83\x86\x9f\x86\x70\x7d\x04\x2d\x2d\xd7\xc2\xab\x3b\x09\xd1\x20"
-DATA ·templatesData+3776(SB)/16,$"\x29\x39\x16\x0d\xb3\xea\x63\x5c\x0f\x5d\xb7\xb8\x19\x5a\x0c\x76"
-DATA ·templatesData+3792(SB)/16,$"\xb5\x2f\xed\x68\xa7\xae\x81\xb1\x81\x5b\x53\xe8\xcc\xe2\x66\xea"
-DATA ·templatesData+3808(SB)/16,$"\x19\xa9\x53\x30\x1f\x63\x31\xbd\xbc\x84\xaf\x6c\x3d\xab\xb0\xac"
-DATA ·templatesData+3824(SB)/16,$"\x45\x6c\x6a\xa5\xdf\xef\xc0\x1b\x18\xcb\x50\x38\xf6\x67\xe7\x4a"
-DATA ·templatesData+3840(SB)/16,$"\xdb\xe4\x3e\x64\x5e\xa1\xe6\x4d\x93\x1d\x7a\x97\x93\x42\x17\x6f"
-DATA ·templatesData+3856(SB)/16,$"\x63\x66\x6d\x57\x4a\x30\xa6\xc9\xc8\xc9\xc9\x75\xcc\x3c\xb3\x43"
-DATA ·templatesData+3872(SB)/16,$"\x30\x6a\xf8\x8a\xd2\xbd\x95\x07\xa5\x5b\xfe\x35\xec\x5a\x69\xcd"
-DATA ·templatesData+3888(SB)/16,$"\x3f\x4f\x38\xe1\x9d\x3c\xa6\x48\xa3\x86\x8e\x54\x0c\x78\xb6\x82"
-DATA ·templatesData+3904(SB)/16,$"\xbf\x95\x4e\xfa\x33\xec\x9e\x4b\x00\x83\x10\x33\x29\x2e\x2f\x53"
-DATA ·templatesData+3920(SB)/16,$"\xf6\x7f\x9a\xb3\xcf\x53\xe8\xc9\xa5\xd5\x9b\x22\xb4\x9c\xff\x50"
-DATA ·templatesData+3936(SB)/16,$"\xae\x11\xb6\xad\x60\xae\x55\xa6\x91\x94\xa3\x30\x23\x98\x2a\x6e"
-DATA ·templatesData+3952(SB)/16,$"\xc6\x25\x23\x85\x4f\x0f\xff\x8a\xe5\x88\xaf\x23\x63\x27\x6a\xc1"
-DATA ·templatesData+3968(SB)/16,$"\x40\x39\xa3\x8a\xa1\x38\xe9\xf1\x63\x88\xc0\x11\x2a\x4f\x18\x78"
-DATA ·templatesData+3984(SB)/16,$"\x38\x57\x84\x03\xce\xb3\x24\xdb\x44\x4f\x1c\xf2\xc1\x58\xc2\x03"
-DATA ·templatesData+4000(SB)/16,$"\x25\x94\x90\xfb\x69\x52\x97\xf0\xe2\x3d\xd3\x59\x5e\x97\x79\xc2"
-DATA ·templatesData+4016(SB)/16,$"\xc9\x8c\xc5\x87\xd3\xc4\xf0\x7f\x01\x00\x00\xff\xff\xc7\x9c\xbb"
-DATA ·templatesData+4032(SB)/16,$"\x28\xe9\x32\x00\x00\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc"
-DATA ·templatesData+4048(SB)/16,$"\x5b\x6f\x73\xdb\x36\xd2\x7f\x4d\x7e\x0a\x98\x33\xee\x90\x7d\x68"
-DATA ·templatesData+4064(SB)/16,$"\xca\xee\xb4\x7d\x5a\x37\xea\x4c\x12\x3b\x9d\xde\xb4\xb9\x4e\xec"
-DATA ·templatesData+4080(SB)/16,$"\xce\xbd\xc8\x64\x32\x90\x08\xca\x88\x29\x52\x47\x40\x76\x9c\x9c"
-DATA ·templatesData+4096(SB)/16,$"\xbe\xfb\xcd\x2e\xfe\x10\x04\x49\x59\x52\x92\x5e\xf3\x22\x22\x41"
-DATA ·templatesData+4112(SB)/16,$"\x60\xf7\x87\xc5\xe2\xb7\x8b\x25\xbd\xa2\xf3\x5b\xba\x60\x84\x2d"
-DATA ·templatesData+4128(SB)/16,$"\x67\x2c\xcf\x59\x1e\x86\x7c\xb9\xaa\x1b\x49\xe2\x30\x88\x66\x0f"
-DATA ·templatesData+4144(SB)/16,$"\x92\x89\x28\x0c\xa2\x79\xbd\x5c\x35\x4c\x88\xc9\xe2\x03\x5f\x61"
-DATA ·templatesData+4160(SB)/16,$"\x43\xf3\xb0\x92\xf5\x44\xdc\xd0\x33\xb8\x65\xd5\xbc\xce\x79\xb5"
-DATA ·templatesData+4176(SB)/16,$"\x98\xcc\xa8\x60\xdf\x7f\x0b\x4d\xbc\x56\xff\x4f\x78\xbd\x96\xbc"
-DATA ·templatesData+4192(SB)/16,$"\x84\x9b\x8a\xc9\xc9\x8d\x94\x28\xa0\x46\xb9\x2b\x2a\x6f\xcc\xef"
-DATA ·templatesData+4208(SB)/16,$"\xa4\xe0\x25\x33\x0d\x0d\x2b\x4a\x36\x97\x70\x29\x99\x90\xbc\x5a"
-DATA ·templatesData+4224(SB)/16,$"\xe0\x25\x5f\x32\xf8\x5d\x57\x82\x16\x2c\x0a\x93\x30\x9c\xd7\x95"
-DATA ·templatesData+4240(SB)/16,$"\x40\xb0\xbc\xca\xd9\x7b\x02\xff\xa6\x24\x7a\x72\x23\x97\xe5\xcf"
-DATA ·templatesData+4256(SB)/16,$"\x4f\x6e\x18\xcd\x59\xf3\xf3\x93\x89\xb9\x98\xd5\xf9\xc3\xcf\x4f"
-DATA ·templatesData+4272(SB)/16,$"\x26\xf0\xf3\x64\x82\x7d\xa2\x30\x58\xf2\x25\xbb\x7e\x58\x31\x1c"
-DATA ·templatesData+4288(SB)/16,$"\x29\xd9\x7b\x89\x4f\x7e\x22\xf3\x1b\xda\x08\x26\xa7\x6b\x59\x9c"
-DATA ·templatesData+4304(SB)/16,$"\xfc\x10\x85\x81\x60\xf2\x9a\x2f\x19\x6a\x38\xfb\xee\xff\x7f\xfc"
-DATA ·templatesData+4320(SB)/16,$"\xe6\x87\x6f\xbe\xfd\xf1\x3b\xad\xf9\x8a\x7f\x60\x64\x4a\x78\x25"
-DATA ·templatesData+4336(SB)/16,$"\xbf\xff\x36\x2e\x59\x15\x63\x6b\x92\x00\xc6\x3b\xda\x58\x84\xcf"
-DATA ·templatesData+4352(SB)/16,$"\xc0\xa4\x44\xe3\x7c\xfd\x06\x2c\xac\xbb\xea\x0e\xcf\xb5\xa9\x59"
-DATA ·templatesData+4368(SB)/16,$"\x4e\xa6\xc4\xd8\x3d\x6e\xc7\x9a\x7e\xd7\x74\x41\x88\x11\x24\xe9"
-DATA ·templatesData+4384(SB)/16,$"\xa2\xd3\x25\x09\xc3\x62\x5d\xcd\xc9\x35\x13\xf2\x5f\x0d\x97\xec"
-DATA ·templatesData+4400(SB)/16,$"\x05\x2f\x59\x2c\xc9\xd7\xda\x98\xd9\x75\x42\x3e\x86\x41\xce\x9b"
-DATA ·templatesData+4416(SB)/16,$"\x94\x14\xe4\x7c\x4a\x96\xf4\x96\xbd\x10\x71\x12\x06\x39\x2b\x58"
-DATA ·templatesData+4432(SB)/16,$"\x43\x6a\x91\xbd\x62\xcb\xfa\x8e\x3d\x2d\xcb\x38\xe7\x4d\x12\x86"
-DATA ·templatesData+4448(SB)/16,$"\x41\x51\x37\xe4\x6d\x4a\x40\x04\x0c\x69\x68\xb5\x60\xe4\xf5\x1b"
-DATA ·templatesData+4464(SB)/16,$"\x21\x9b\xf5\x5c\x82\xb8\xa0\xa2\x68\x1e\x42\x84\x6c\x78\xb5\x08"
-DATA ·templatesData+4480(SB)/16,$"\x83\x00\xd6\xb4\xdb\x72\x43\xc5\x65\xd3\xd4\x0d\x99\xd5\x75\x19"
-DATA ·templatesData+4496(SB)/16,$"\x06\x41\x4e\x25\xc5\x1e\xca\x18\x61\xb0\x01\x49\x1f\xa3\x57\x6c"
-DATA ·templatesData+4512(SB)/16,$"\x55\xd2\x39\x8b\x52\x12\x4d\x04\x93\x80\x5a\x64\xb0\x30\x51\x4a"
-DATA ·templatesData+4528(SB)/16,$"\x0a\x5a\x0a\x96\x1a\xf3\x45\xa2\x5e\x32\x02\x72\xa2\x64\x93\xe2"
-DATA ·templatesData+4544(SB)/16,$"\xe0\xa7\x79\x8e\x03\xf9\x92\x2e\x98\x98\xac\xf8\x5c\xae\x1b\x96"
-DATA ·templatesData+4560(SB)/16,$"\x89\xbb\xc5\xc8\x68\xec\xd8\x95\xf1\x8c\xe6\xe4\x0f\x70\xc7\x1e"
-DATA ·templatesData+4576(SB)/16,$"\x82\xc9\x3b\x31\x01\x9f\x16\xd9\x3b\x11\xa5\x44\x36\x6b\x5f\x9c"
-DATA ·templatesData+4592(SB)/16,$"\x98\x37\x7c\x25\x1d\x79\x1b\xb4\x8f\xcc\x5e\xad\xab\x18\x0c\x98"
-DATA ·templatesData+4608(SB)/16,$"\x81\xa9\x52\x02\x8b\xd4\x5b\x96\x30\x08\x02\xd6\x34\x60\xe3\x22"
-DATA ·templatesData+4624(SB)/16,$"\x73\x56\x0f\x86\x81\x3d\xd5\x12\x64\x20\x3c\x85\x85\xfa\xbd\xce"
-DATA ·templatesData+4640(SB)/16,$"\xd9\x1f\xac\x59\x26\x38\x92\x17\x04\x06\x4f\xa7\xa4\xe2\x25\x6a"
-DATA ·templatesData+4656(SB)/16,$"\xc5\x36\x1c\x62\x6d\xaf\x9a\x03\x99\xe1\x6d\x11\x47\xe0\x28\xe4"
-DATA ·templatesData+4672(SB)/16,$"\x58\x90\x9c\xe7\xa4\xaa\x25\x88\xa8\x1b\x42\x05\x61\xef\x57\x6c"
-DATA ·templatesData+4688(SB)/16,$"\x2e\x19\x98\xd3\xe2\x4e\x70\xf4\x06\xfe\xdf\x10\x56\x0a\xd6\xaa"
-DATA ·templatesData+4704(SB)/16,$"\x39\xda\x51\x4f\xc3\xe4\xba\xa9\x58\x4e\xd6\x95\xd1\xa0\x75\x1e"
-DATA ·templatesData+4720(SB)/16,$"\xdf\xb9\xaa\x52\x68\x75\xf5\x99\x39\x0e\x2a\xe2\x05\x51\x06\xb2"
-DATA ·templatesData+4736(SB)/16,$"\xe6\xfb\xe7\x8a\x55\xad\xe5\x92\x9f\xf0\xc9\x91\x6b\x9b\x01\x70"
-DATA ·templatesData+4752(SB)/16,$"\xf5\x8a\x55\x28\xe8\x20\x98\xae\x45\x00\xd1\xcc\xc2\x51\x4c\x98"
-DATA ·templatesData+4768(SB)/16,$"\xbd\x62\x34\x87\x6d\x35\x8a\x68\x00\x92\x1e\x73\x08\x20\x0f\x11"
-DATA ·templatesData+4784(SB)/16,$"\x1a\x4f\xd3\x6b\x76\xc1\xd8\xea\xf2\xdf\x6b\x5a\xc6\x33\xc7\xab"
-DATA ·templatesData+4800(SB)/16,$"\x12\xdb\xd7\x41\x72\xa1\x3d\x63\xc1\xa4\x75\x0a\x32\xaf\x2b\xc9"
-DATA ·templatesData+4816(SB)/16,$"\x2a\x29\x48\x7c\x2c\x12\xdd\x8c\xd7\x51\x4a\x3a\x12\xb5\xbc\x4d"
-DATA ·templatesData+4832(SB)/16,$"\xe8\xfc\xa8\xb5\x84\xeb\x4d\x12\x06\x9b\x70\xe3\x92\x16\x2d\x6f"
-DATA ·templatesData+4848(SB)/16,$"\xff\x32\xbe\xf2\xd9\xca\xde\xc3\xd2\x10\xa2\x0c\x0c\xb7\x6a\x7e"
-DATA ·templatesData+4864(SB)/16,$"\x28\x02\x3b\x68\xaa\x72\x99\x62\x46\x61\xab\x54\xbc\x4c\x6d\xbf"
-DATA ·templatesData+4880(SB)/16,$"\x8f\xd8\xb8\xd1\xb4\xf2\x4b\x5d\x2b\x6e\xea\x77\x9b\x28\xce\x02"
-DATA ·templatesData+4896(SB)/16,$"\x1e\x37\x4c\xd7\xe7\xbe\x08\xe3\xa4\x68\xaf\x26\xef\xba\x37\xae"
-DATA ·templatesData+4912(SB)/16,$"\x00\xa3\xf4\xea\x96\xaf\x8c\x52\x13\x66\x33\x68\xbc\x00\x8b\xba"
-DATA ·templatesData+4928(SB)/16,$"\x08\x36\xfb\xb2\x55\x10\x04\x10\xdf\x4a\x2e\x5c\xcb\x04\x41\x91"
-DATA ·templatesData+4944(SB)/16,$"\xa9\x35\x6c\x59\x0b\x87\x83\x66\x6d\xe0\x94\xf0\xaa\xa8\x09\x90"
-DATA ·templatesData+4960(SB)/16,$"\xdb\xaf\x55\x51\xab\x6d\x82\xb6\x4e\xb4\x4f\x2b\x37\x44\xd1\x53"
-DATA ·templatesData+4976(SB)/16,$"\x42\x57\x2b\x56\xe5\x31\xdc\xa5\x04\xc4\x28\xa7\x52\x3b\x42\xb9"
-DATA ·templatesData+4992(SB)/16,$"\x1a\x6b\x1a\x74\x29\xcb\x84\x03\x8e\xae\xc6\xab\xee\xb8\x9e\xc6"
-DATA ·templatesData+5008(SB)/16,$"\xdb\xfb\xae\x0e\x13\x50\x3c\xe0\x10\x21\x59\xd4\x32\x3e\xbe\x73"
-DATA ·templatesData+5024(SB)/16,$"\xbc\xfd\x0e\xbc\xbd\x2f\xb6\xeb\xdc\x1d\xef\x7e\x9a\xe7\x03\xac"
-DATA ·templatesData+5040(SB)/16,$"\xff\xc5\xa2\xf1\x50\x3c\x76\xda\xb8\x78\x61\x5a\x75\x4c\xb6\xb4"
-DATA ·templatesData+5056(SB)/16,$"\x4a\x7a\x51\xba\x8d\xd3\xc1\xbc\xcd\x54\x74\x2f\x01\x49\x90\xfa"
-DATA ·templatesData+5072(SB)/16,$"\x87\x89\x90\xd9\x21\x60\x0a\x40\x73\x8e\x8f\xa2\x8b\xf5\xaa\xe4"
-DATA ·templatesData+5088(SB)/16,$"\x73\x2a\x19\x29\xea\x32\x67\x4d\x94\xa2\xc3\xf0\xd2\x74\x70\x1c"
-DATA ·templatesData+5104(SB)/16,$"\x1b\x9e\x18\x38\xe7\x2a\xd4\x82\x4d\xd3\xbe\xd8\xae\x60\x5e\x32"
-DATA ·templatesData+5120(SB)/16,$"\x5f\x2c\xf1\x36\x17\xfa\x08\xce\x5d\x3d\x37\xc2\x1d\x7d\x4e\x23"
-DATA ·templatesData+5136(SB)/16,$"\x58\xe0\xdc\x4e\xae\x93\xa8\xe1\xf3\xd6\x1a\x2d\x4c\x34\xc8\xb9"
-DATA ·templatesData+5152(SB)/16,$"\x6b\x11\x37\x35\xdc\x32\x11\x60\x14\x4c\x85\xb7\x4e\x01\xcd\xf4"
-DATA ·templatesData+5168(SB)/16,$"\xf7\x9f\xcc\xe3\xab\x02\x4e\xac\xd7\x7c\xa7\x05\xfa\x72\xf0\x0f"
-DATA ·templatesData+5184(SB)/16,$"\x60\x3e\xcb\x5a\x86\x74\x70\x9c\xde\x54\x8a\x5a\x84\xc4\xf8\xff"
-DATA ·templatesData+5200(SB)/16,$"\x75\xfc\xb5\xda\x74\x49\xac\x4e\x30\xd9\x1f\x35\xaf\x24\x6b\xe2"
-DATA ·templatesData+5216(SB)/16,$"\xaf\xda\x48\xa9\x58\x0d\x53\x38\x52\x64\x4f\xf3\xdc\x4f\xfe\x90"
-DATA ·templatesData+5232(SB)/16,$"\xbb\x9f\x51\xe1\x34\x26\x29\x89\x4c\xf4\x87\x69\xa6\x04\x4e\x4a"
-DATA ·templatesData+5248(SB)/16,$"\xd9\xcb\xfa\x3e\x4e\xb2\x3f\x2b\xfe\x3e\xd6\x3d\x22\x65\xd4\x20"
-DATA ·templatesData+5264(SB)/16,$"\xc0\xae\xad\x99\x3a\x29\xa5\x90\x2a\x73\xe8\xe4\x0d\x2e\x20\xdc"
-DATA ·templatesData+5280(SB)/16,$"\xb4\x3b\x42\xf2\x71\x24\x9d\x2c\xee\x80\x4c\x55\x91\xb3\x4a\x43"
-DATA ·templatesData+5296(SB)/16,$"\xfc\x24\x95\xdc\xdf\xb0\x8a\xd0\x1c\x8e\xa2\xe4\x58\x18\x93\x20"
-DATA ·templatesData+5312(SB)/16,$"\x9e\xc3\x73\xd6\x5f\x6a\xe9\xa6\x5b\xae\x8e\xae\x3e\x27\x07\xb3"
-DATA ·templatesData+5328(SB)/16,$"\x69\x68\x27\x77\x1d\xca\x74\x60\x79\xc5\xdf\x28\x1a\x54\xb5\x34"
-DATA ·templatesData+5344(SB)/16,$"\xe1\x40\xb3\xba\x0e\x74\x1d\xee\xe7\xe2\x82\x37\xc4\x8d\x19\x65"
-DATA ·templatesData+5360(SB)/16,$"\x3d\xa7\x65\xa7\xa5\x17\x1f\x06\x82\x41\x54\xd5\x43\xac\xa0\x59"
-DATA ·templatesData+5376(SB)/16,$"\x4e\x38\x44\xa0\x71\x3d\x1a\x03\x70\xe0\x2e\xd4\xaf\xa6\x75\xde"
-DATA ·templatesData+5392(SB)/16,$"\x92\x08\x9e\x9d\x47\xf9\xa3\xaf\x2e\x32\xa9\x59\x57\x5b\x2f\x65"
-DATA ·templatesData+5408(SB)/16,$"\x73\x95\x75\x15\x0d\xc8\x54\x65\x0c\x34\xa6\x2f\x76\x6c\x02\x1e"
-DATA ·templatesData+5424(SB)/16,$"\x78\x1c\x7b\x4e\xc6\x81\x47\x03\x51\x17\x32\x43\x45\xb7\x17\xdc"
-DATA ·templatesData+5440(SB)/16,$"\x8b\xb3\xfb\x1e\x5d\x8b\xec\x4f\xc1\x7e\x03\x10\xaa\x3b\xe2\x51"
-DATA ·templatesData+5456(SB)/16,$"\x19\xd9\xf6\xc3\xd9\xa3\xa4\x60\x5c\x73\x84\x13\xcc\xb9\x84\x48"
-DATA ·templatesData+5472(SB)/16,$"\x45\x0f\xe8\xdd\xc3\x2c\x10\x1a\x0e\xbc\x92\x54\xc6\xb2\xb3\x69"
-DATA ·templatesData+5488(SB)/16,$"\x9d\x23\x36\x5a\x43\x5f\x3b\xd3\x68\x49\x43\x6d\x83\x8f\x2d\xa7"
-DATA ·templatesData+5504(SB)/16,$"\x42\x22\x3b\x2e\xaf\x47\xb8\x5a\xa8\x03\x6a\xc7\x03\x63\xc7\x3e"
-DATA ·templatesData+5520(SB)/16,$"\x4e\xd0\x71\xe0\x38\xf6\x01\x01\xa4\xae\x74\xba\x65\x0f\xf9\x3a"
-DATA ·templatesData+5536(SB)/16,$"\x75\x56\x34\x0a\x0c\x32\x64\xac\xfd\x8e\x8f\xdd\x94\xda\x03\xa1"
-DATA ·templatesData+5552(SB)/16,$"\x8f\xae\x98\x4b\x1a\x0c\x4b\x2a\xe7\x37\xb8\x5c\xce\x11\x32\xf7"
-DATA ·templatesData+5568(SB)/16,$"\x8e\x90\x4e\x46\xdd\x3b\x44\xfa\xc7\xed\xa3\xdd\x2c\xd1\x9e\xee"
-DATA ·templatesData+5584(SB)/16,$"\x3b\xf3\x1f\xa7\xf0\xce\xa1\x75\x8b\x07\x5c\x31\x76\x7b\x25\x69"
-DATA ·templatesData+5600(SB)/16,$"\xb3\xc5\xad\x3a\xd3\x31\x63\x9e\xaf\x9b\x86\x55\xfb\x8e\xba\xac"
-DATA ·templatesData+5616(SB)/16,$"\xf2\x3d\x47\x3c\xa3\x3b\x8e\x70\x76\x09\x58\xed\x82\x37\x8f\x6c"
-DATA ·templatesData+5632(SB)/16,$"\x14\xbd\x39\x30\x29\x7d\x5e\xd6\x82\xc5\x89\x95\x80\xf7\x43\x8a"
-DATA ·templatesData+5648(SB)/16,$"\xd5\xa0\xe1\x90\x3c\xba\xdf\x7f\xb1\x15\xaa\x7a\xc5\xaa\xc7\x83"
-DATA ·templatesData+5664(SB)/16,$"\xaf\x5b\x1c\x1a\xad\xfe\x80\xd0\x59\xbd\x58\x0b\xd5\x6d\xc4\x1f"
-DATA ·templatesData+5680(SB)/16,$"\xfc\x5c\xa2\x17\xd3\x4d\xb4\x26\xb1\x39\xe5\xc2\x1c\xae\x1e\x84"
-DATA ·templatesData+5696(SB)/16,$"\x64\x4b\xdc\x17\x72\xb9\xc2\xd8\xfe\xd6\xd9\xe1\xd7\x6c\x09\x47"
-DATA ·templatesData+5712(SB)/16,$"\xf1\x18\x73\xb4\x42\x9c\x80\xc2\x08\xe3\x3a\x74\x7a\xc9\xee\xe3"
-DATA ·templatesData+5728(SB)/16,$"\xef\xf1\xce\xe6\x83\x7e\x99\xc0\x8b\x0d\xf6\x8c\xff\x8f\x9a\x57"
-DATA ·templatesData+5744(SB)/16,$"\xb1\xd1\xe8\xf6\xc2\x8c\xd7\x56\xae\x53\xa2\x8b\xdb\x29\x31\xd5"
-DATA ·templatesData+5760(SB)/16,$"\xf0\x94\x98\x32\xb3\xa9\x70\xfa\x99\xb6\xce\x19\x62\xaf\x3d\xf1"
-DATA ·templatesData+5776(SB)/16,$"\x91\xf6\x2a\x18\xfd\xf8\x38\x82\xb7\xdb\x71\x2f\xc8\xba\xc8\xeb"
-DATA ·templatesData+5792(SB)/16,$"\xc4\x46\xa2\xcb\xec\x5d\x70\xea\xd4\x51\x88\x83\xec\x69\x2b\x31"
-DATA ·templatesData+5808(SB)/16,$"\x85\x88\xfe\x07\xe6\x55\x79\x78\xb7\x0c\xa4\xcf\xcc\x8f\x01\x7e"
-DATA ·templatesData+5824(SB)/16,$"\x27\x14\x44\x83\x2b\x0c\x82\x11\x5b\x84\xc1\x88\xc2\xa8\x95\xf7"
-DATA ·templatesData+5840(SB)/16,$"\x98\xc2\x31\x55\x0a\x6c\x5f\xbe\x2e\x55\x41\xda\xa1\x04\xf9\xc3"
-DATA ·templatesData+5856(SB)/16,$"\xbd\xe5\x19\x4a\xb9\x2c\x4a\xa3\xe0\x79\xbd\x7a\xb0\xc0\x3a\x65"
-DATA ·templatesData+5872(SB)/16,$"\x73\x5b\x43\xd2\x0f\x0b\xbb\x99\x3d\x42\x77\xb2\x9d\x94\x60\x42"
-DATA ·templatesData+5888(SB)/16,$"\x6d\xf6\x38\x52\xc6\x8d\x94\xab\xec\x85\x22\x1f\x53\x2a\x84\x7c"
-DATA ·templatesData+5904(SB)/16,$"\x19\xb7\xfd\x64\x82\xe9\x3e\xb1\xe2\x42\x60\xa4\xaa\x4d\x87\x80"
-DATA ·templatesData+5920(SB)/16,$"\x36\xe1\x61\x7c\x76\x9a\x12\x5e\x67\xb6\x63\x3f\xe4\x1f\x90\x24"
-DATA ·templatesData+5936(SB)/16,$"\x8c\x86\x6d\x85\xf4\xf5\xd9\xe9\xf9\x9b\x7e\x25\xec\xb0\x98\xed"
-DATA ·templatesData+5952(SB)/16,$"\x8a\xb4\x24\xe9\x30\xfc\x48\x38\xa6\x85\x64\x4d\x67\xe6\xe3\x11"
-DATA ·templatesData+5968(SB)/16,$"\xba\x53\xe3\x46\x82\x07\x63\x02\xb7\x9f\x9d\xfa\x4a\x40\x5c\x57"
-DATA ·templatesData+5984(SB)/16,$"\xac\x97\x00\x1c\xe7\xed\x09\xf2\xec\xb4\xcd\x84\x2a\xa5\x47\xa9"
-DATA ·templatesData+6000(SB)/16,$"\x70\x67\xb0\x87\xec\xed\xd0\x37\x3d\x4f\xb3\x69\xc0\xe7\xf2\x35"
-DATA ·templatesData+6016(SB)/16,$"\x2d\x10\xbd\xed\xed\x80\xb7\xed\xe2\x6c\x8f\x78\xa9\x56\x31\xec"
-DATA ·templatesData+6032(SB)/16,$"\x75\x7b\x67\xb3\xfb\x78\xea\x67\x77\xd5\x81\x0a\xc1\x2e\xde\xaa"
-DATA ·templatesData+6048(SB)/16,$"\x2d\xb0\xa3\xbf\x2a\x25\xbe\xc3\x7e\x66\x8f\xdd\xb2\xeb\x5c\xf1"
-DATA ·templatesData+6064(SB)/16,$"\x06\xf9\x5e\x6e\x8b\xdb\xa1\xe7\xba\x98\x8b\x7e\x82\xdb\x76\xfd"
-DATA ·templatesData+6080(SB)/16,$"\xf6\xb2\xca\x47\x19\xf2\xc4\x71\xbe\xcb\x2a\xff\x4b\x08\xb2\x64"
-DATA ·templatesData+6096(SB)/16,$"\x55\xac\xd3\xe3\x93\x2f\x40\x96\x3d\xf1\x87\x12\xe7\x65\x95\xef"
-DATA ·templatesData+6112(SB)/16,$"\xbe\x88\x41\x89\x06\xb2\xd5\x50\x8d\x20\x21\x27\xe4\xec\xd4\x21"
-DATA ·templatesData+6128(SB)/16,$"\xd5\xf2\x93\x38\xf5\x38\xef\x78\x68\xb9\x1f\xad\x1e\xe4\xa1\xce"
-DATA ·templatesData+6144(SB)/16,$"\x0b\x97\xce\xc1\xe7\x13\x59\x15\xeb\x6b\x02\xc0\xcd\x58\x51\x37"
-DATA ·templatesData+6160(SB)/16,$"\x30\x1a\xe7\xae\x8e\x16\xa3\x1c\x7b\xf2\x78\x48\xdf\x52\xe0\x84"
-DATA ·templatesData+6176(SB)/16,$"\x39\x8e\xea\xd4\x56\x55\xf6\xdc\x38\x7b\x68\x46\x55\xa9\x72\xce"
-DATA ·templatesData+6192(SB)/16,$"\xb6\x51\xff\xc9\xd9\x81\x50\xac\xf4\x31\x20\x1d\xf3\x63\x95\x65"
-DATA ·templatesData+6208(SB)/16,$"\x77\xdb\xab\x43\xf5\xac\xae\xcb\x54\x95\xc2\xf0\x1a\x17\x81\x17"
-DATA ·templatesData+6224(SB)/16,$"\xf8\xd6\xd0\x9b\x0d\xc8\xef\xbf\x4a\x77\xfc\x49\xd2\x01\x72\xd6"
-DATA ·templatesData+6240(SB)/16,$"\xb0\x07\xbc\xa7\xf5\xc9\x19\x15\x0c\xf4\xb4\x25\x6e\xe3\xbb\xb8"
-DATA ·templatesData+6256(SB)/16,$"\x37\x70\xf7\x14\x75\xf6\x92\x2e\x19\x20\xc0\xdd\x82\x63\xbc\x0d"
-DATA ·templatesData+6272(SB)/16,$"\x03\x1d\xfc\xc2\xcb\x1d\x2d\x79\x0e\xff\xaf\x19\x59\xd4\x1d\x82"
-DATA ·templatesData+6288(SB)/16,$"\x80\x5d\xa3\xb7\x0b\x48\xb3\x59\xce\xb2\xce\xf1\x1b\xa4\xf3\xa9"
-DATA ·templatesData+6304(SB)/16,$"\x2a\xad\x63\x51\xdd\x1e\x2e\x4e\x7b\xb8\x7e\x57\xfd\x2d\x34\x33"
-DATA ·templatesData+6320(SB)/16,$"\xde\x43\xa7\xbb\x3d\x0e\xf0\xce\x05\x78\xa7\x00\x6a\x99\x6e\x26"
-DATA ·templatesData+6336(SB)/16,$"\xc6\x85\x45\xf0\x2b\xac\x25\xe8\xe7\x02\x00\x38\xf5\x92\x56\x3d"
-DATA ·templatesData+6352(SB)/16,$"\xf6\x39\x40\x39\x17\xda\x57\x5c\xdd\xe2\xa1\x55\x7e\xf5\x20\x40"
-DATA ·templatesData+6368(SB)/16,$"\x35\x34\xb9\x5c\xef\x38\xc6\x83\xf0\xf5\xae\xab\x9c\x35\xe5\x03"
-DATA ·templatesData+6384(SB)/16,$"\xaf\x16\x5a\x79\xcb\x5d\xd4\x99\xa5\x42\x82\x9f\x7d\xa1\x36\x7d"
-DATA ·templatesData+6400(SB)/16,$"\xd4\x33\xf3\xb7\xb3\x74\xfa\x4d\xc9\xa9\x8b\xd3\x0e\x05\xa0\xfc"
-DATA ·templatesData+6416(SB)/16,$"\x03\x2e\x12\x36\x1e\x4d\x89\x33\xca\x47\x0c\x6d\xce\xeb\x88\x21"
-DATA ·templatesData+6432(SB)/16,$"\xd3\xb4\x90\xd5\xdb\xa0\x56\x9a\x37\x09\x08\x7a\x6a\x83\x39\x58"
-DATA ·templatesData+6448(SB)/16,$"\xe1\x60\x04\xc0\x9c\x33\x12\xf9\x8f\xb9\xbb\xe0\x8d\x89\x96\x6e"
-DATA ·templatesData+6464(SB)/16,$"\xe9\xcb\x19\xd9\x19\xd8\x79\xdb\xb3\xd4\x82\x8d\x67\xe2\x8c\xb1"
-DATA ·templatesData+6480(SB)/16,$"\xd1\xce\x18\x25\xf8\x81\x15\x1b\x77\x9e\x32\x08\x4c\x1d\x71\x7e"
-DATA ·templatesData+6496(SB)/16,$"\x4a\x14\xfa\xb9\x8b\xad\x6e\x1d\x42\x50\x86\x94\x3c\x7e\x05\x99"
-DATA ·templatesData+6512(SB)/16,$"\x39\x6f\x62\xcb\xaf\x47\x9d\xe4\x64\x70\x17\xb4\x55\x2d\x3d\x7a"
-DATA ·templatesData+6528(SB)/16,$"\x7b\xfe\xd5\x72\x94\xb7\x18\x5b\xf8\xbb\x2b\xd8\x75\x69\x27\xcb"
-DATA ·templatesData+6544(SB)/16,$"\x18\x9f\xcd\xd9\x50\xbe\x3e\xf2\x89\x10\xea\x6b\xd8\x9c\x96\xe5"
-DATA ·templatesData+6560(SB)/16,$"\xb8\xda\xd6\x37\xde\x1e\x7a\xc4\xf0\xb3\x06\xbf\xcc\x6d\xb6\x35"
-DATA ·templatesData+6576(SB)/16,$"\x45\xe6\xdf\x17\xc2\x70\xdc\x3e\xda\xa2\x5f\xd6\x64\xc6\x16\xbc"
-DATA ·templatesData+6592(SB)/16,$"\xc2\xb2\x24\xc4\x46\x05\x66\x8f\x93\x41\x2f\xb9\x56\x2f\x15\x76"
-DATA ·templatesData+6608(SB)/16,$"\xf7\x4e\xef\xc5\xdb\x78\x0c\xad\x6f\xed\x6c\x63\xf3\x19\x4e\xf2"
-DATA ·templatesData+6624(SB)/16,$"\x13\x34\x6b\xb7\x32\x92\xec\xae\x6d\xeb\x50\xb0\x77\xed\xe3\xa3"
-DATA ·templatesData+6640(SB)/16,$"\xa9\xab\xd4\xf3\x42\x23\xd9\x19\xbc\xfb\x6e\x36\x62\xdd\x59\xf5"
-DATA ·templatesData+6656(SB)/16,$"\x49\x4c\xd2\x85\x85\x78\x4d\x17\x80\x0d\x9a\x8e\xa6\xb6\xca\x36"
-DATA ·templatesData+6672(SB)/16,$"\x0a\x0a\x9e\xed\x8c\x46\xd2\x85\x5b\xb8\xf3\x61\x2c\x75\x68\x56"
-DATA ·templatesData+6688(SB)/16,$"\x04\xa7\x2b\x7d\x48\x72\xf0\x00\xc2\xaf\xf9\xd4\x78\x0c\x8d\x19"
-DATA ·templatesData+6704(SB)/16,$"\xb4\x07\xdd\x79\x65\x45\x1f\x93\xfe\xf6\x40\x45\x19\x55\x51\x84"
-DATA ·templatesData+6720(SB)/16,$"\x38\x23\x1b\x6b\x9d\x51\x30\xaa\xfb\x1e\xc1\x46\x36\xda\x3a\x7d"
-DATA ·templatesData+6736(SB)/16,$"\x18\xb3\x75\x61\x61\x60\x3d\x16\x50\x0c\x1d\xb8\xd6\x85\x5b\xb5"
-DATA ·templatesData+6752(SB)/16,$"\x4d\x3e\x1b\x38\x4f\xf0\x60\x34\xb7\x91\x1c\xbb\x68\xaa\xf6\xdd"
-DATA ·templatesData+6768(SB)/16,$"\xde\x0d\x95\x64\xea\x97\x6d\x47\xa6\xfc\x8a\xde\x6f\x9b\x70\xe7"
-DATA ·templatesData+6784(SB)/16,$"\xb5\xd9\xe7\x9a\xac\x7a\xd2\x9d\xa8\x17\x35\x9c\xd8\x3f\x4c\xe3"
-DATA ·templatesData+6800(SB)/16,$"\x06\x01\xc1\xef\x54\x0a\x3a\x1f\x4c\x87\x3c\xbe\x32\x2f\x7b\x76"
-DATA ·templatesData+6816(SB)/16,$"\x65\x2c\x9c\xf7\xdb\xa1\x94\x3e\x1c\x78\x1f\xec\x65\xf5\x75\x45"
-DATA ·templatesData+6832(SB)/16,$"\xe6\xa8\x4f\x09\xed\x7e\xc0\xec\x1f\x8e\xb4\x92\x7e\x90\xde\xae"
-DATA ·templatesData+6848(SB)/16,$"\xc8\xc4\xaf\x4f\xd0\x35\x18\x4d\x1e\x99\x9e\x0e\x67\x9f\x32\xbd"
-DATA ·templatesData+6864(SB)/16,$"\x78\x49\x6f\x59\xac\x0e\xb1\x29\x39\x3b\x4d\x76\x98\xe9\x7e\x3a"
-DATA ·templatesData+6880(SB)/16,$"\x1d\x85\xf6\xa5\xdf\x36\x0d\xd8\x69\x1f\x15\xc6\xb3\xec\xdf\x42"
-DATA ·templatesData+6896(SB)/16,$"\x60\x79\xc5\x9c\xcb\xd5\x2f\xe8\xb8\xa3\x0d\x6e\x39\xfc\xa3\x95"
-DATA ·templatesData+6912(SB)/16,$"\xec\xd9\xba\x28\x58\x13\x86\xc1\xe2\xde\x3a\xd6\xe2\x03\x5f\x65"
-DATA ·templatesData+6928(SB)/16,$"\x2f\xd9\x3d\x7e\x53\xdf\xfc\xc6\xee\x58\x19\x7f\x85\x5b\x05\x9f"
-DATA ·templatesData+6944(SB)/16,$"\x3c\x03\xd7\xd5\x4a\x78\x5d\x25\xe1\xd0\x44\xac\x89\x17\xf7\xea"
-DATA ·templatesData+6960(SB)/16,$"\xdb\xfc\x58\x7f\xdf\xbc\x19\xec\x6e\xfb\x5a\xdb\x0c\xf7\xd3\xe9"
-DATA ·templatesData+6976(SB)/16,$"\xc1\x6c\x5d\x18\x76\x54\x3d\x75\x3b\xe8\x68\xb7\x18\x5d\x74\x6d"
-DATA ·templatesData+6992(SB)/16,$"\xa0\x76\x15\xc8\xb9\xa1\xe2\x06\x66\x2a\x6e\xe8\x59\x76\xb5\x5e"
-DATA ·templatesData+7008(SB)/16,$"\x1a\x70\x46\x3c\xfe\x75\x0e\x90\xd1\x9f\xaf\x7e\xbb\xd4\x7f\xb3"
-DATA ·templatesData+7024(SB)/16,$"\x93\xe1\x05\xbb\xae\x75\x74\x00\x19\xaf\xcf\xdf\x24\xe4\xff\x48"
-DATA ·templatesData+7040(SB)/16,$"\x74\xb2\xf8\x10\x85\x9b\xf0\xbf\x01\x00\x00\xff\xff\x7a\xc8\x8c"
-DATA ·templatesData+7056(SB)/16,$"\xea\x25\x34\x00\x00\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4"
-DATA ·templatesData+7072(SB)/16,$"\x58\x5b\x73\xdb\xb8\x15\x7e\x26\x7f\xc5\x59\x3e\xc4\x64\x2c\x53"
-DATA ·templatesData+7088(SB)/16,$"\xe9\x24\xd3\x07\xef\xa8\x3b\xad\xeb\x24\x9e\xd9\x4d\xbd\xb6\x3b"
-DATA ·templatesData+7104(SB)/16,$"\xfb\xb0\xb3\x0f\x10\x79\x28\xa1\x06\x01\x05\x00\xa5\xa8\x19\xff"
-DATA ·templatesData+7120(SB)/16,$"\xf7\xce\x39\x00\x6f\x8e\x92\x66\x5a\x3d\x24\x12\x70\x6e\xf8\xf0"
-DATA ·templatesData+7136(SB)/16,$"\x9d\x0b\xbc\x13\xd5\xa3\xd8\x20\x60\xbb\xc6\xba\xc6\x3a\x4d\x65"
-DATA ·templatesData+7152(SB)/16,$"\xbb\x33\xd6\x43\x9e\x26\x99\x46\xbf\xdc\x7a\xbf\xcb\xd2\x24\x33"
-DATA ·templatesData+7168(SB)/16,$"\x8e\xfe\xdd\x09\xbf\xa5\xff\x9d\xb7\x95\xd1\xfb\xf8\x55\xea\x8d"
-DATA ·templatesData+7184(SB)/16,$"\xcb\xd2\x22\x4d\x97\x4b\x78\x2f\x74\xad\xd0\x82\x43\xbb\x47\x37"
-DATA ·templatesData+7200(SB)/16,$"\xd8\x85\x2d\xaf\x83\x37\x61\x07\xde\x4a\x85\xf7\x47\xe7\xb1\x4d"
-DATA ·templatesData+7216(SB)/16,$"\xfd\x71\x87\x83\x9e\xd4\x1e\x6d\x23\x2a\x84\xcf\x69\x42\xce\xcb"
-DATA ·templatesData+7232(SB)/16,$"\xb8\x93\x26\xcb\x25\xdc\xa3\xff\x60\xfc\x5b\xd3\xe9\x7a\x74\xe4"
-DATA ·templatesData+7248(SB)/16,$"\x41\xb0\x79\xb4\x64\x7e\x8d\x50\x09\xa5\xb0\x86\xc6\x58\xd0\x06"
-DATA ·templatesData+7264(SB)/16,$"\x1a\x92\x4e\x93\x2f\x55\xf3\xa9\xf9\x82\xed\xdf\x34\xe0\x6d\x87"
-DATA ·templatesData+7280(SB)/16,$"\x20\x74\x0d\x7e\x8b\xd0\x18\x45\x66\x6b\x83\x0e\xb4\xf1\x50\x19"
-DATA ·templatesData+7296(SB)/16,$"\xed\x85\xd4\x20\x75\x8d\x9f\xca\xad\x6f\x15\x58\x64\xcf\x41\x92"
-DATA ·templatesData+7312(SB)/16,$"\x8d\x18\xbf\x45\x7b\x90\x0e\xc1\xa2\xef\xac\x86\x37\xaf\x5e\x03"
-DATA ·templatesData+7328(SB)/16,$"\xfb\xba\xf7\xc2\x77\xee\xad\xb1\x6b\x59\xd7\xa8\x39\xa8\x3b\xd6"
-DATA ·templatesData+7344(SB)/16,$"\x7f\xcb\xea\x2e\x47\x2d\xd6\x0a\x61\x6d\x8c\x2a\xd2\xa7\x34\x60"
-DATA ·templatesData+7360(SB)/16,$"\xc3\x88\x59\x70\xde\x76\x95\x1f\x70\x99\x20\x98\xe8\x78\x32\xe0"
-DATA ·templatesData+7376(SB)/16,$"\xcf\x1c\x36\x77\x74\x30\x7e\xe6\x7b\x76\xea\x9c\xbd\x92\xd3\xe5"
-DATA ·templatesData+7392(SB)/16,$"\x12\xde\xa1\x67\xfb\xc1\x73\x65\x51\x78\x24\x98\x49\x7b\x1b\xb5"
-DATA ·templatesData+7408(SB)/16,$"\x97\x4b\xf0\x5b\xe9\xe0\x20\x95\x8a\xb7\xda\x48\x85\xd0\x58\xd3"
-DATA ·templatesData+7424(SB)/16,$"\x32\x7a\xc3\xe5\x8f\xa1\x96\x29\xdf\xa2\xdd\x4b\xbd\x81\xcd\xbf"
-DATA ·templatesData+7440(SB)/16,$"\xe5\x8e\x55\x1c\x5d\x5c\xa5\x24\x6a\xef\xc0\x6f\x85\x07\x51\x55"
-DATA ·templatesData+7456(SB)/16,$"\xb8\x23\xbc\xdb\x9d\x45\xe7\xb0\x66\xe8\x51\x7b\x90\x0d\xdb\x1e"
-DATA ·templatesData+7472(SB)/16,$"\x7e\xba\x89\xd0\xcc\xfa\xb5\x17\x9b\x8b\xb5\x88\xba\xb5\xf4\xd2"
-DATA ·templatesData+7488(SB)/16,$"\x68\x41\xf7\xf5\xb1\x43\xe7\x1d\x19\x72\x3b\xac\x64\x23\x49\xb1"
-DATA ·templatesData+7504(SB)/16,$"\xe9\x74\x35\x3f\x75\xde\x38\x78\x06\x74\x31\xd0\xf4\x33\x61\xc7"
-DATA ·templatesData+7520(SB)/16,$"\x97\xfb\x22\xdc\xce\xe7\x34\x49\x46\xc1\x4b\x82\xba\x71\x8b\x34"
-DATA ·templatesData+7536(SB)/16,$"\x21\xf8\x2f\x9f\xe3\x3f\x73\x52\x90\xd4\xec\x22\x2e\x99\x84\x8b"
-DATA ·templatesData+7552(SB)/16,$"\x34\x79\x8a\xb7\xf1\xbf\xd3\x9e\x8f\x95\x3b\x78\x19\xa2\x2c\xe0"
-DATA ·templatesData+7568(SB)/16,$"\x54\x1a\xcc\x48\x51\xd0\xd9\x5c\x39\x30\x6a\x05\xdb\x18\xc5\xff"
-DATA ·templatesData+7584(SB)/16,$"\x9d\x1c\xb3\xdc\xa0\x68\xd9\x4c\x1f\x0e\xf4\xbc\x3a\x15\xf3\xd7"
-DATA ·templatesData+7600(SB)/16,$"\xb3\x24\x84\x3b\x27\xf2\x0a\x82\xc4\x00\x9f\xdd\xe3\xfb\x87\x87"
-DATA ·templatesData+7616(SB)/16,$"\x5b\x90\xed\x4e\x61\x4b\xbc\x99\x1e\x79\x2c\x3a\xa7\x7c\x47\xdd"
-DATA ·templatesData+7632(SB)/16,$"\xfc\x10\x74\xee\xd0\xed\x8c\x76\xf8\x9b\x95\x1e\xed\x02\x2c\xbc"
-DATA ·templatesData+7648(SB)/16,$"\x8c\xeb\xcc\x2b\x8e\xa7\x32\xda\xf9\x80\xc3\x2d\x55\xd7\x15\x64"
-DATA ·templatesData+7664(SB)/16,$"\xcb\x11\x95\x2c\x4d\x93\x8e\x2a\x29\x5c\xae\xc0\x96\xff\xbc\xfb"
-DATA ·templatesData+7680(SB)/16,$"\xb9\xbc\x15\x7e\x9b\x26\xb2\x81\x1f\x62\x39\x2d\xdf\x0b\x77\x6b"
-DATA ·templatesData+7696(SB)/16,$"\xb1\x91\x9f\x72\x16\x5d\x40\xb6\xcc\xd8\x76\x54\x25\x93\x19\x9c"
-DATA ·templatesData+7712(SB)/16,$"\x03\xff\x22\x02\x0d\x76\x60\xd5\x2f\x3e\xa5\x69\xa2\x45\x8b\xe4"
-DATA ·templatesData+7728(SB)/16,$"\x87\x56\xca\x2b\x85\x42\x07\x83\x45\xca\xb5\xca\x62\x2d\x2d\x56"
-DATA ·templatesData+7744(SB)/16,$"\x1e\xca\xb2\x9c\x84\x48\x8c\xa2\x15\x96\xa9\x84\x3e\xf3\xd0\x39"
-DATA ·templatesData+7760(SB)/16,$"\x84\xbb\x28\x9d\x17\xb0\xc6\x4a\xd0\x12\x67\xeb\xc1\x74\xaa\x86"
-DATA ·templatesData+7776(SB)/16,$"\x56\x3c\x22\xdf\x28\x07\x28\xd6\xce\xa8\xce\x13\x8d\x97\x4b\x38"
-DATA ·templatesData+7792(SB)/16,$"\x6c\x65\xb5\x8d\x72\x6b\xaa\x24\x3b\x6b\xd6\x0a\x5b\xb0\x9d\xd6"
-DATA ·templatesData+7808(SB)/16,$"\x94\xad\x1d\x13\xe5\xde\x5b\xb9\x0b\xe7\x66\x38\x26\x68\xdc\x77"
-DATA ·templatesData+7824(SB)/16,$"\x0d\xa1\x31\x9e\x73\x31\x02\x1c\x80\x51\xa6\x12\x6a\x08\xf1\xb0"
-DATA ·templatesData+7840(SB)/16,$"\x00\xbb\x80\xac\x5c\x66\x05\xe7\x17\x25\x6b\x80\x64\x2f\x2c\xd4"
-DATA ·templatesData+7856(SB)/16,$"\x60\x1c\xa7\xe1\x8d\x6e\x4c\x9a\x26\xcd\x02\xd0\x5a\x02\xca\x95"
-DATA ·templatesData+7872(SB)/16,$"\xff\xd8\xa1\xce\x09\xb7\x82\x63\xa0\xf5\xd5\x0a\xb4\x54\xec\xa5"
-DATA ·templatesData+7888(SB)/16,$"\xc6\x86\x18\x5d\x5e\x29\xe3\x30\x27\xdb\x75\xd0\x5d\x41\xc3\x05"
-DATA ·templatesData+7904(SB)/16,$"\x9e\xd6\xc8\x4d\x54\xfd\x61\x54\x75\xa5\x37\x44\xa5\x6b\x6b\x8d"
-DATA ·templatesData+7920(SB)/16,$"\x8d\x01\xa2\xb5\xcf\xe3\x9b\x5e\x0b\xd5\x45\xa1\x8d\x96\x95\x50"
-DATA ·templatesData+7936(SB)/16,$"\x8c\xeb\x25\x2c\x41\x78\x40\x5d\x83\x69\x20\x48\x19\x7b\x84\xce"
-DATA ·templatesData+7952(SB)/16,$"\xaa\xa0\x39\xf2\x40\xa8\x83\x38\x3a\x58\xe3\x46\x6a\xaa\xd2\x7e"
-DATA ·templatesData+7968(SB)/16,$"\x0b\xcb\x34\xe9\xac\x3a\xc1\xbb\xba\xbc\x71\x7f\x97\x36\x0f\x48"
-DATA ·templatesData+7984(SB)/16,$"\xca\x86\xec\xfd\xae\x50\xe7\x9d\x55\xc5\xc5\x9f\xfe\xa0\x63\x9c"
-DATA ·templatesData+8000(SB)/16,$"\x2d\xcf\x78\xf7\x24\xd0\xcc\xaf\xbf\x09\x87\xac\x71\x9e\x05\xd8"
-DATA ·templatesData+8016(SB)/16,$"\x87\x73\x25\x4f\x69\xf2\x04\xa8\x1c\x7e\xcd\xc1\xea\xbf\x38\xc8"
-DATA ·templatesData+8032(SB)/16,$"\xca\x72\x99\x9d\xcf\xdd\x7c\xe9\x22\xc0\x47\xc4\x8c\x0d\xc2\x11"
-DATA ·templatesData+8048(SB)/16,$"\x4c\x13\x62\x53\x75\x1c\x50\x5b\x50\x13\xa0\xde\x81\xda\x9f\x82"
-DATA ·templatesData+8064(SB)/16,$"\x81\xd4\x98\x13\x91\x86\x0f\x56\xb6\x91\x87\xc4\x8f\x98\x94\xe7"
-DATA ·templatesData+8080(SB)/16,$"\x23\x11\xd3\x24\x69\xbe\xa0\x12\xef\x16\xe1\xd4\xcf\xc8\xd4\xb3"
-DATA ·templatesData+8096(SB)/16,$"\x69\x4a\xa7\xa4\xae\x07\x0b\xcd\x48\xa9\x93\xea\x09\xd5\xe7\xba"
-DATA ·templatesData+8112(SB)/16,$"\xe6\xaf\x0d\x31\xb0\xa1\xaf\x4f\x33\x30\xee\x3d\xf5\x67\x31\x9e"
-DATA ·templatesData+8128(SB)/16,$"\xfa\x27\xc8\x0f\x08\xb5\xac\x29\xad\x1b\xa9\x6b\x10\xb3\xa2\x4d"
-DATA ·templatesData+8144(SB)/16,$"\x1d\xb9\x38\xcd\x8a\xe7\x85\x96\x83\x70\xa5\x3b\xba\x72\x52\x28"
-DATA ·templatesData+8160(SB)/16,$"\x17\xc0\x9c\x9e\xdc\xf7\x49\xea\x1b\x57\x5e\x5b\x7b\x8b\xb6\x95"
-DATA ·templatesData+8176(SB)/16,$"\xce\x49\xa3\x8b\x10\xf6\x2c\x17\x64\x03\x16\x45\x4d\x85\xd6\x3c"
-DATA ·templatesData+8192(SB)/16,$"\x32\x22\x65\xfe\x32\xac\x14\x3f\xd2\xd2\x67\x56\xa0\xdf\x25\x97"
-DATA ·templatesData+8208(SB)/16,$"\xec\xde\xfb\xc4\x79\xdf\x01\xae\xe2\xc8\xc0\xf3\x4a\xb5\xc5\xea"
-DATA ·templatesData+8224(SB)/16,$"\x11\x5a\x53\xcb\x46\x56\x82\x66\x03\xf0\xb2\xa5\x0b\x0c\x93\xda"
-DATA ·templatesData+8240(SB)/16,$"\x44\x21\x86\x5b\x97\x1f\x44\x8b\x79\x41\xdf\x7e\x31\xf5\x83\x0c"
-DATA ·templatesData+8256(SB)/16,$"\x3f\x9a\x62\xec\xd3\x93\x33\xc6\xd9\xcf\x81\x00\x6d\xf4\x45\x9c"
-DATA ·templatesData+8272(SB)/16,$"\x34\x2a\xe0\x36\x84\x2c\xd1\xa2\x73\xd4\x1d\xa8\x9f\x3a\x9e\x0c"
-DATA ·templatesData+8288(SB)/16,$"\xa1\x32\x35\x92\x21\x62\xa9\x80\x8d\xdc\xa3\x66\x75\xba\xf0\xa0"
-DATA ·templatesData+8304(SB)/16,$"\xb4\x17\xaa\xc3\x12\x6e\xfc\x99\x83\x30\xaa\x0b\xed\x43\x15\x9e"
-DATA ·templatesData+8320(SB)/16,$"\x7a\xef\x9b\x32\x19\x13\x95\xef\x84\x52\xc7\x7e\x1c\x45\x6b\xcb"
-DATA ·templatesData+8336(SB)/16,$"\x70\x0f\xc5\x02\x9c\xd4\x15\x42\xeb\x36\x1c\x06\x9d\x3d\x0c\xa9"
-DATA ·templatesData+8352(SB)/16,$"\x20\x6c\x3f\xbf\x62\x4d\x65\xa8\x73\x68\xdd\x82\xed\x91\xa0\x74"
-DATA ·templatesData+8368(SB)/16,$"\xde\x58\xaa\x4a\xea\x08\xef\xcc\x99\x9b\x43\x1c\x4b\xcf\xa0\xff"
-DATA ·templatesData+8384(SB)/16,$"\xaf\xce\x79\xc8\xde\xbc\x7a\x43\xdd\x1e\xb8\xdd\x67\x74\x48\x36"
-DATA ·templatesData+8400(SB)/16,$"\xa7\xe2\xd9\x5c\x09\xbf\x21\xd4\x86\x68\x79\xe0\x53\x19\xc2\xc5"
-DATA ·templatesData+8416(SB)/16,$"\x7a\x50\x28\x1e\xa9\x49\x48\xdd\x18\xdb\x86\xdb\x92\x7a\x0e\xa3"
-DATA ·templatesData+8432(SB)/16,$"\x2b\xbf\x6c\xde\x33\xce\x7d\x57\xfb\x0e\x99\xc7\x86\x89\xf4\x61"
-DATA ·templatesData+8448(SB)/16,$"\xd4\x8e\x88\x5c\xae\xa6\x53\xfc\x0d\xcd\x0c\x5a\xa8\x30\xc7\xb1"
-DATA ·templatesData+8464(SB)/16,$"\x8f\xc0\x57\xe3\xca\x1b\xf7\xc1\xf8\xeb\x4f\xd2\xf9\x9c\xea\x3b"
-DATA ·templatesData+8480(SB)/16,$"\xb3\x70\x62\x68\x66\xa7\x1f\x7f\xfa\x04\x1b\x06\xaf\x49\xe3\x98"
-DATA ·templatesData+8496(SB)/16,$"\xcc\x63\x27\xf2\xec\x1b\x45\x96\x63\x19\x33\x6c\x8c\xe6\xab\xe1"
-DATA ·templatesData+8512(SB)/16,$"\x4c\x1e\x27\x7d\x0d\xe1\xdd\x21\x71\x27\xb2\x0f\xf8\xc9\xe7\xa3"
-DATA ·templatesData+8528(SB)/16,$"\xa1\x62\x31\xe1\x4f\x11\x53\x62\x56\xca\x99\xd1\x94\x12\xbf\x98"
-DATA ·templatesData+8544(SB)/16,$"\x3d\xd6\x40\x81\x09\x8d\xda\x33\x37\xc3\xbd\xf0\x08\x7f\xe3\x67"
-DATA ·templatesData+8560(SB)/16,$"\x53\xe5\x1e\xad\x07\x8b\x4a\x78\xb9\x0f\xd3\x05\x3f\x18\xfa\x09"
-DATA ·templatesData+8576(SB)/16,$"\x23\xae\x28\xf9\x38\x4e\x28\xac\x1f\x19\xf1\xac\x9b\x7c\x27\x0f"
-DATA ·templatesData+8592(SB)/16,$"\x34\x1e\xb8\x8b\x86\xda\xcf\xa8\xc9\x06\x3e\x8e\xbd\xf3\x4e\x1c"
-DATA ·templatesData+8608(SB)/16,$"\x7e\xed\xd0\x1e\x7f\x84\x8f\x74\x57\x59\xc6\xc0\xf6\x6a\xe7\x2b"
-DATA ·templatesData+8624(SB)/16,$"\xc8\x7e\xa2\x01\xed\x23\x81\x98\x1c\xca\xf7\x5c\xa4\xf2\xa2\xbc"
-DATA ·templatesData+8640(SB)/16,$"\x47\x9f\x67\x3f\x9b\x50\x74\xb2\xc1\x51\x41\x42\x1c\x4d\x94\x9c"
-DATA ·templatesData+8656(SB)/16,$"\x00\xcd\x70\x4d\xd0\xea\xc1\x0d\x8f\x2e\x7a\x0a\xec\x85\x95\xa6"
-DATA ·templatesData+8672(SB)/16,$"\x73\xb0\x65\x5d\x07\xe8\xc5\x66\x31\x3c\x92\xe8\x15\x19\xb3\xa3"
-DATA ·templatesData+8688(SB)/16,$"\x81\xbe\x7c\x42\xac\x97\xdf\x3f\xd6\x7a\xb1\x09\x25\xd8\x8b\x4d"
-DATA ·templatesData+8704(SB)/16,$"\xe8\x2d\x57\x5c\x45\xa5\xeb\x5f\x55\x94\xa4\x93\x37\x1b\x55\x8a"
-DATA ·templatesData+8720(SB)/16,$"\x03\xc2\x56\xec\x11\xe4\xf4\x35\xc7\x58\x36\xe5\x44\xf4\xc5\x8b"
-DATA ·templatesData+8736(SB)/16,$"\xa1\xcb\x5e\x85\x77\x84\xcb\x6d\x04\xad\x7c\x47\x90\xfd\x95\x9f"
-DATA ·templatesData+8752(SB)/16,$"\x84\x17\xd7\xba\x32\xb5\xd4\x9b\xac\x58\x40\x46\x2f\xc8\x38\x16"
-DATA ·templatesData+8768(SB)/16,$"\x3f\x47\x38\x96\xa2\x51\x7e\x10\xa7\x26\x5d\x12\x08\x57\xa3\xfb"
-DATA ·templatesData+8784(SB)/16,$"\x15\x3f\x6d\x78\x47\xa1\xde\xf0\x14\x2d\xb5\xff\xf3\x9b\x9c\x66"
-DATA ·templatesData+8800(SB)/16,$"\x94\xa6\xac\x85\x17\x45\xf1\x3c\xbd\x68\xcf\x8b\x4d\x01\x7f\x81"
-DATA ·templatesData+8816(SB)/16,$"\xd7\x21\xad\x08\xa2\x15\x78\xb1\xf9\xfd\xb2\xdf\xbc\x78\xfd\xc7"
-DATA ·templatesData+8832(SB)/16,$"\x98\x4b\x51\xa9\x29\x5b\xd9\xe2\xc3\x71\x87\xa4\xfb\xea\x9b\x07"
-DATA ·templatesData+8848(SB)/16,$"\x20\xa9\x6c\x01\x13\x95\x99\xa9\xe8\xff\xb4\x0d\x7a\x03\x67\x0b"
-DATA ·templatesData+8864(SB)/16,$"\x88\x7f\xae\x29\x7f\xed\x8c\x47\xd6\x28\xc6\xf1\xe0\xfb\x5b\xe3"
-DATA ·templatesData+8880(SB)/16,$"\xd7\x3a\x63\x33\x74\xc6\xe6\x59\x67\x7c\x4a\xff\x13\x00\x00\xff"
-DATA ·templatesData+8896(SB)/16,$"\xff\x3f\x8e\xa1\xaf\x61\x12\x00\x00\x1f\x8b\x08\x00\x00\x00\x00"
-DATA ·templatesData+8912(SB)/16,$"\x00\x02\xff\xcc\x95\x51\x6f\xdb\x36\x10\xc7\x9f\xc9\x4f\x71\x15"
-DATA ·templatesData+8928(SB)/16,$"\x90\x42\x2a\x34\xa6\xcf\x03\xbc\x21\xcd\xec\x64\x58\x97\x05\x8e"
-DATA ·templatesData+8944(SB)/16,$"\x8b\x01\x2b\x8a\x41\x16\x4f\x8e\x56\x9a\x74\x8e\x27\x3b\x59\xe1"
-DATA ·templatesData+8960(SB)/16,$"\xef\x3e\x90\x96\x6c\xd9\x71\xdd\x0e\xdd\xc3\xf2\xc0\x58\x47\xf2"
-DATA ·templatesData+8976(SB)/16,$"\x77\xa7\xbb\xd3\xff\x16\x45\xf9\xb1\x98\x21\xe0\x7c\x8a\x5a\xa3"
-DATA ·templatesData+8992(SB)/16,$"\x96\xb2\x9e\x2f\x1c\x31\xa4\x52\x24\x16\xf9\xfc\x9e\x79\x91\xf4"
-DATA ·templatesData+9008(SB)/16,$"\x7e\xc7\x85\xd1\x73\x30\x3a\x1f\x56\xc2\xca\x60\x19\x0d\x61\xa3"
-DATA ·templatesData+9024(SB)/16,$"\xb6\xb3\x44\x66\x52\x56\x8d\x2d\x61\x82\x9e\xdf\xba\xb2\x30\xbf"
-DATA ·templatesData+9040(SB)/16,$"\xe3\xf4\x0e\x69\x89\x29\xc3\xab\xf6\x94\x9a\x64\xf0\x49\x0a\x5d"
-DATA ·templatesData+9056(SB)/16,$"\x53\x0e\x95\x87\xef\x07\x30\x2f\x3e\xe2\xc8\xa7\x99\x14\x1a\x2b"
-DATA ·templatesData+9072(SB)/16,$"\x24\x70\x5e\x8d\x71\xee\x96\x78\x61\x4c\xaa\x6b\xca\xa4\x14\xf1"
-DATA ·templatesData+9088(SB)/16,$"\xe0\x15\xf2\xa8\x36\x18\x89\x94\x56\x3e\x93\xc2\xab\x3b\xe4\x1b"
-DATA ·templatesData+9104(SB)/16,$"\xc7\x23\xd7\x58\x7d\x5d\x58\x6d\x90\xd2\x10\xac\x6a\x1f\x46\x8d"
-DATA ·templatesData+9120(SB)/16,$"\x2d\x37\x86\xee\x54\x16\x78\x95\x23\xf8\x33\x07\xe6\xc0\xa5\xc2"
-DATA ·templatesData+9136(SB)/16,$"\xce\x10\xde\x7f\xf0\x4c\x4d\xc9\x21\x3a\x61\x8b\x39\xc2\xf6\xcf"
-DATA ·templatesData+9152(SB)/16,$"\x33\xd5\x76\x26\x85\x68\xc8\xc0\x11\xb3\x5b\x22\x51\xad\xf1\xc0"
-DATA ·templatesData+9168(SB)/16,$"\x4c\xf8\xd0\xa0\xe7\xab\x3f\xea\x05\x00\x4c\x9d\x33\x52\x08\x13"
-DATA ·templatesData+9184(SB)/16,$"\xf2\xb2\x45\xb4\x46\x42\xab\x91\x46\xce\x68\x24\xdf\x19\xf1\x71"
-DATA ·templatesData+9200(SB)/16,$"\x81\x25\x77\x27\xdf\x7f\x98\x3e\x31\x4a\x21\x3c\x17\xdc\xf8\xce"
-DATA ·templatesData+9216(SB)/16,$"\x5c\x5b\x96\x62\x1d\x42\xfe\x94\xd4\x56\xe3\x23\x94\x6e\xbe\x20"
-DATA ·templatesData+9232(SB)/16,$"\xf4\x1e\x75\x92\x43\x72\x1e\x96\x24\x07\xa6\x06\x73\xa8\x0a\xe3"
-DATA ·templatesData+9248(SB)/16,$"\xb1\x7b\x88\xc7\x2f\xb7\xa7\x73\x88\x69\xba\x8b\xf8\xdf\x7e\x59"
-DATA ·templatesData+9264(SB)/16,$"\xe7\x3d\x66\x63\x8f\x53\x5b\xde\x73\xec\x9b\x27\x46\x7f\x8a\x48"
-DATA ·templatesData+9280(SB)/16,$"\xa8\x6b\x0a\xfd\x13\x68\xd1\xa4\xee\x79\x6e\x7e\xd4\x38\x6d\x66"
-DATA ·templatesData+9296(SB)/16,$"\x83\x40\xfa\x7c\xe0\xb6\x36\x7b\xe8\x5f\xdd\x12\xf5\x2d\xd2\xbc"
-DATA ·templatesData+9312(SB)/16,$"\xb0\x68\xd9\x3c\xed\x39\x2a\xb4\x06\x6f\x0a\x7f\x7f\xe0\x29\x3c"
-DATA ·templatesData+9328(SB)/16,$"\xee\x3d\x1d\x7b\x97\xaf\xf4\x64\x1d\x43\x15\x7a\x2b\xfa\x98\x16"
-DATA ·templatesData+9344(SB)/16,$"\xfa\x44\x7a\x0e\x91\x5d\x5b\x6e\x51\x9b\x5e\x80\x2a\x36\x43\x04"
-DATA ·templatesData+9360(SB)/16,$"\x56\xb5\x41\x7f\xfe\x97\x3f\x5a\xcb\xf6\xdf\x21\x76\xe4\x68\x5a"
-DATA ·templatesData+9376(SB)/16,$"\x6b\x8d\xb6\xe5\x7e\x2d\xee\x78\x90\xdb\xe2\x6d\x30\xfb\xd5\xeb"
-DATA ·templatesData+9392(SB)/16,$"\x78\xdf\x5c\xb0\x00\xda\x47\x7b\xe4\xa0\x19\x3e\xd6\xe8\xfc\x9b"
-DATA ·templatesData+9408(SB)/16,$"\x1d\x74\xb8\xe7\xec\x2f\x7c\x25\x27\xda\x79\xf3\x3d\x57\x5f\x62"
-DATA ·templatesData+9424(SB)/16,$"\xf6\xd7\x93\xc8\x75\xd4\x1f\x56\xe3\xc6\xa6\xcc\x2a\x08\x51\x0e"
-DATA ·templatesData+9440(SB)/16,$"\x41\x55\x9f\x69\xa8\x14\x42\xac\x82\x7e\x75\xe2\xac\x6e\x70\x35"
-DATA ·templatesData+9456(SB)/16,$"\xc6\xd2\x91\x46\x0a\x72\x2a\x04\x3d\xdf\x8e\x92\x94\x26\x57\xc3"
-DATA ·templatesData+9472(SB)/16,$"\x49\x88\x8d\x55\x43\x26\xe6\x2f\x9e\xaf\x2b\x30\x18\xfd\x76\x92"
-DATA ·templatesData+9488(SB)/16,$"\x96\xc1\x0f\xf0\x3a\x86\x24\x04\xa9\x77\xe3\xb7\xea\xb6\xe0\x7b"
-DATA ·templatesData+9504(SB)/16,$"\x18\x40\xef\x4c\xd8\x5c\xcb\xf6\x3e\xb3\xea\xeb\x5e\x77\xf3\x1a"
-DATA ·templatesData+9520(SB)/16,$"\x0b\x8d\xa4\x2e\xb4\x4e\x93\x8b\xb2\xc4\x05\x7f\x37\xb4\xa5\xd3"
-DATA ·templatesData+9536(SB)/16,$"\x61\x6e\xe4\x90\xcc\xfe\xae\x17\x49\xb6\x03\x55\x5e\xbd\xf3\x18"
-DATA ·templatesData+9552(SB)/16,$"\x67\x48\x88\x26\x26\x39\x6e\x47\xc1\x1f\xf7\xe5\x32\x8d\x1e\x7b"
-DATA ·templatesData+9568(SB)/16,$"\x86\xed\x39\x5a\xe2\xf5\x64\x72\x9b\xae\x72\x88\x43\xa4\x8b\xaf"
-DATA ·templatesData+9584(SB)/16,$"\x55\xd0\x17\x03\x78\x0d\x2f\x5f\xc2\x4a\x8d\xd1\x37\x86\xd3\xac"
-DATA ·templatesData+9600(SB)/16,$"\x2d\xc4\xa5\xd3\x18\x76\x77\x47\x37\x6f\xc1\x6a\x48\xe4\xa8\x4a"
-DATA ·templatesData+9616(SB)/16,$"\x93\xb3\x07\x05\x14\x2f\xc1\x00\xce\x74\x0e\xab\xc2\x32\x9c\xe9"
-DATA ·templatesData+9632(SB)/16,$"\x4d\x4a\x37\x35\x3b\x8a\xcd\x77\xd0\xec\x30\x6d\xad\xde\xbf\x18"
-DATA ·templatesData+9648(SB)/16,$"\x84\x72\xb4\x2e\xeb\x0a\x5e\xb4\x93\x56\xfd\x84\xb8\x18\x3e\x34"
-DATA ·templatesData+9664(SB)/16,$"\x85\x49\x57\xea\x8d\xd3\x4f\x2a\xb6\x50\x9a\xe5\xbb\xcb\x59\x7b"
-DATA ·templatesData+9680(SB)/16,$"\xed\x20\xd4\x99\x0b\x71\xa6\x67\x3e\x6b\x23\x0d\x3f\xf7\x63\xfd"
-DATA ·templatesData+9696(SB)/16,$"\x1c\x30\xe2\xd6\xb2\x5d\xd6\x99\x14\x6b\xb9\xde\x4d\xf9\x89\x0b"
-DATA ·templatesData+9712(SB)/16,$"\x19\x8e\xbe\x8e\xf4\xe7\x91\xa9\x1d\x3b\x4d\x0a\x4f\xcb\xb0\xe7"
-DATA ·templatesData+9728(SB)/16,$"\x55\xfa\xca\xc7\x8d\x7f\x31\x94\xb7\xf3\x15\x89\xc2\x08\xc4\xe0"
-DATA ·templatesData+9744(SB)/16,$"\x7d\x37\x18\xfb\x13\xb1\x2f\xcc\xce\x87\x9c\xdc\x38\x1e\x3e\xd6"
-DATA ·templatesData+9760(SB)/16,$"\x9e\x4f\x69\xf0\x02\x69\x5e\x7b\x5f\x3b\xbb\xbd\x76\xbb\x35\x9d"
-DATA ·templatesData+9776(SB)/16,$"\x54\xd9\xcd\xbb\x6c\x6f\x5d\x1a\x77\x38\x58\x7f\xb6\x8c\x64\x0b"
-DATA ·templatesData+9792(SB)/16,$"\xb3\x49\x47\x4c\xdc\x7f\xfb\xd5\xc7\xe6\xa7\xa5\xe2\x5e\x65\x56"
-DATA ·templatesData+9808(SB)/16,$"\xad\x42\x86\xb2\xd2\xff\xf7\x53\xe8\xfa\x4b\xae\xe5\x3f\x01\x00"
-DATA ·templatesData+9824(SB)/16,$"\x00\xff\xff\x0a\x3a\x79\xa8\xa2\x0a\x00\x00\x4d\x42\x4c\x78\x6d"
-DATA ·templatesData+9840(SB)/16,$"\x6d\x41\x34\x4d\x54\x6f\x32\x61\x78\x49\x43\x32\x4c\x56\x49\x4e"
-DATA ·templatesData+9856(SB)/16,$"\x73\x47\x6e\x77\x43\x41\x2d\x67\x7a\x5a\x65\x5a\x4e\x57\x4c\x41"
-DATA ·templatesData+9872(SB)/16,$"\x56\x74\x35\x6e\x65\x4f\x5a\x71\x4f\x76\x47\x6d\x48\x50\x41\x32"
-DATA ·templatesData+9888(SB)/16,$"\x59\x6e\x67\x6b\x2d\x67\x7a\x69\x39\x52\x57\x79\x33\x65\x5a\x37"
-DATA ·templatesData+9904(SB)/16,$"\x6e\x33\x31\x35\x6b\x6f\x51\x5f\x68\x4f\x67\x73\x6c\x64\x75\x53"
-DATA ·templatesData+9920(SB)/16,$"\x4c\x41\x2d\x67\x7a\x76\x65\x4c\x75\x43\x30\x65\x79\x37\x78\x7a"
-DATA ·templatesData+9936(SB)/16,$"\x47\x49\x34\x4c\x4b\x6c\x65\x70\x51\x72\x74\x56\x43\x76\x51\x73"
-DATA ·templatesData+9952(SB)/16,$"\x2d\x67\x7a\x74\x65\x78\x74\x2f\x70\x6c\x61\x69\x6e\x3b\x20\x63"
-DATA ·templatesData+9968(SB)/16,$"\x68\x61\x72\x73\x65\x74\x3d\x75\x74\x66\x2d\x38\x2f\x73\x65\x72"
-DATA ·templatesData+9984(SB)/16,$"\x76\x65\x72\x5f\x74\x65\x73\x74\x2e\x67\x6f\x2f\x66\x73\x5f\x74"
-DATA ·templatesData+10000(SB)/16,$"\x65\x73\x74\x2e\x67\x6f\x2f\x73\x65\x72\x76\x65\x72\x2e\x67\x6f"
-DATA ·templatesData+10016(SB)/6,$"\x2f\x66\x73\x2e\x67\x6f"
-GLOBL ·templatesData(SB),(NOPTR+RODATA),$10022
+DATA ·templatesData+0(SB)/16,$"\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x54\xc1\x6e\xdb\x30"
+DATA ·templatesData+16(SB)/16,$"\x0c\x3d\x8b\x5f\xc1\xe5\x64\x0f\xa9\xb2\x06\x4b\x0f\x29\x32\xa0"
+DATA ·templatesData+32(SB)/16,$"\xe8\x06\x14\xd8\xb0\x43\xb3\x61\x87\x22\x07\xd5\xa6\x62\x21\xb6"
+DATA ·templatesData+48(SB)/16,$"\x64\x48\x72\x8a\x2c\xc8\xbf\x0f\x92\x6c\x27\xe9\x71\x17\x4b\x7a"
+DATA ·templatesData+64(SB)/16,$"\x24\x1f\x1f\x45\xca\xad\x28\x76\x62\x4b\x48\xcd\x2b\x95\x25\x95"
+DATA ·templatesData+80(SB)/16,$"\x00\xaa\x69\x8d\xf5\x98\x01\x9b\xbc\x1e\x3c\xb9\x09\xb0\x49\x61"
+DATA ·templatesData+96(SB)/16,$"\x9a\xd6\x92\x73\xb3\xed\x5f\xd5\x46\xc0\x1e\x5a\x6f\x66\xae\x12"
+DATA ·templatesData+112(SB)/16,$"\xf3\xc5\xdd\x35\xb0\xb8\x9d\x07\x80\x74\x61\x4a\xa5\xb7\xb3\x57"
+DATA ·templatesData+128(SB)/16,$"\xe1\xe8\xee\x73\x80\x2a\xe1\xaa\xb0\x2a\x33\x81\x1c\xa0\x30\xda"
+DATA ·templatesData+144(SB)/16,$"\xc5\x3c\xb3\x19\x7e\x55\x5b\x72\x7e\xfd\xf4\x30\x5f\xdc\xe1\xfa"
+DATA ·templatesData+160(SB)/16,$"\xe9\xe1\x26\xac\x65\x04\x51\xd4\x5b\x63\x95\xaf\x1a\x94\xc6\xe2"
+DATA ·templatesData+176(SB)/16,$"\x33\xb5\xf6\x26\xb9\x63\x45\xa2\x24\xeb\x80\x5d\x85\xaf\x70\xe2"
+DATA ·templatesData+192(SB)/16,$"\x2a\x71\x93\x84\x5d\x72\x2f\x6e\xe7\x91\x3b\xac\xff\xc1\x1d\xc2"
+DATA ·templatesData+208(SB)/16,$"\x7a\xee\x58\x63\x0e\xb0\x17\xb6\x67\x7a\x12\xae\x22\x87\x2b\x6c"
+DATA ·templatesData+224(SB)/16,$"\x44\xfb\xe2\xbc\x55\x7a\xbb\x91\x9d\x2e\xb2\x1c\x43\xd9\x3c\xd8"
+DATA ·templatesData+240(SB)/16,$"\x8f\xd7\x42\x97\x98\xae\x8f\xff\xa4\xb7\xe9\x75\x9e\x68\x5a\xdc"
+DATA ·templatesData+256(SB)/16,$"\xce\x93\xe9\x04\xe0\x0f\x2d\xf5\x99\xbe\xd3\x01\x9d\xb7\x5d\xe1"
+DATA ·templatesData+272(SB)/16,$"\xf1\x08\xec\x5c\x40\x4a\x0a\x6c\xb8\x78\x1c\x90\x13\xc0\x6c\x36"
+DATA ·templatesData+288(SB)/16,$"\x14\x6c\xc9\x77\x56\x3b\xf4\x15\x61\xea\xcc\x60\x31\x32\x82\x52"
+DATA ·templatesData+304(SB)/16,$"\xd5\x84\x85\xd1\x9e\xb4\x77\xd8\xb9\xc0\x34\x26\x99\xa2\x92\x38"
+DATA ·templatesData+320(SB)/16,$"\x24\x08\xac\xca\xa1\x23\x1f\x03\x7b\x1a\xe5\x06\xa6\x91\x44\x69"
+DATA ·templatesData+336(SB)/16,$"\xf4\x95\xf0\x03\x80\x29\x9c\xf7\x8d\x71\x28\x6c\x70\x6e\xda\xce"
+DATA ·templatesData+352(SB)/16,$"\x53\x19\x48\x8d\x46\xa9\xac\xf3\xd8\x39\xe2\x10\x6e\x11\x33\x89"
+DATA ·templatesData+368(SB)/16,$"\x1f\x83\xb2\xbc\x4f\x93\xbd\xaf\x7b\x3a\xca\xea\x81\x1c\xb3\xbd"
+DATA ·templatesData+384(SB)/16,$"\xa8\x3b\x1a\x8f\x47\x60\x3b\x3a\xe0\x72\x75\xbe\xc7\xe3\xc8\xb2"
+DATA ·templatesData+400(SB)/16,$"\xbc\xac\x71\x60\x5a\x8e\xbb\x13\x00\x93\x3c\xc5\xfd\x30\xc5\x8e"
+DATA ·templatesData+416(SB)/16,$"\x87\x4f\x96\x03\x2b\x49\x92\xc5\x2b\xd3\x6f\x5d\x27\x23\x30\x25"
+DATA ·templatesData+432(SB)/16,$"\x71\x3f\x45\xb3\x0b\x49\x07\x1f\xf7\xb2\xa3\xc3\xe6\x3e\xa0\x47"
+DATA ·templatesData+448(SB)/16,$"\x60\x2c\xf5\x03\xf7\xc0\x4e\x29\x40\xd3\x5b\x98\x95\xb3\xce\x34"
+DATA ·templatesData+464(SB)/16,$"\x59\x2f\xa3\xbe\xcd\xfd\xe8\xf3\x61\x85\x5a\xd5\x91\x27\x06\xf4"
+DATA ·templatesData+480(SB)/16,$"\x78\xcc\x1d\xb8\x6a\xd2\xd9\x50\x42\x8e\x5f\xf0\x53\x74\x65\x15"
+DATA ·templatesData+496(SB)/16,$"\xff\x63\x95\xa7\x4c\xf2\x68\xa4\xf2\xec\x94\x03\x63\x27\xa4\xda"
+DATA ·templatesData+512(SB)/16,$"\x51\xe8\xb4\xe4\xc3\xc3\xa7\x32\x85\x2a\x89\x9d\x0e\x3f\x81\x29"
+DATA ·templatesData+528(SB)/16,$"\x92\xb5\x21\x67\x38\x84\x31\x7d\x8e\xef\x25\x8b\xbf\x8c\x8b\xb3"
+DATA ·templatesData+544(SB)/16,$"\xe4\xa5\xf0\x22\xcf\xef\xa3\xff\xea\x2c\x98\x31\x65\xf8\xa3\x69"
+DATA ·templatesData+560(SB)/16,$"\x0f\x59\x35\xed\x39\xf3\x08\xa7\x3d\x7f\xac\x8d\xa3\x2c\x42\xa7"
+DATA ·templatesData+576(SB)/16,$"\xb3\xa8\x77\xfa\x23\x77\xb0\x86\x82\x53\xbb\x57\xfd\x58\xf3\xb5"
+DATA ·templatesData+592(SB)/16,$"\x2f\xbf\xf5\x65\xf1\xb8\xa1\x5f\x66\x1d\x67\x21\xab\xf8\xba\x6b"
+DATA ·templatesData+608(SB)/16,$"\x32\xad\xea\x7c\xb8\xa9\xb1\x3b\x57\x12\x2f\x50\x6c\xc4\x8e\xb2"
+DATA ·templatesData+624(SB)/16,$"\xf0\xb8\xc7\xe9\xd9\xf4\x93\x05\x49\xe1\x75\x83\x71\x85\x51\x4f"
+DATA ·templatesData+640(SB)/16,$"\xea\x6b\xea\x32\x9c\xe0\xdf\x00\xd3\x4e\xd5\x87\x6f\x05\x00\x00"
+DATA ·templatesData+656(SB)/16,$"\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x3c\x5d\x8f\xdb\x38"
+DATA ·templatesData+672(SB)/16,$"\x92\xcf\xd2\xaf\xa8\xf5\x43\xaf\x94\x78\xe4\xcc\x5d\xb0\x7b\x70"
+DATA ·templatesData+688(SB)/16,$"\xc6\x01\xb2\x93\xe4\x90\xbb\x9d\xcc\x20\x9d\xc5\x3d\x04\xc1\x80"
+DATA ·templatesData+704(SB)/16,$"\xb6\xa8\x36\xb7\x65\xd1\x47\xd2\xdd\xf1\x76\xfa\xbf\x1f\xaa\x8a"
+DATA ·templatesData+720(SB)/16,$"\xa4\x28\xc9\x9d\x74\x3e\x06\xb8\x3c\x24\x16\xc5\x2a\xd6\x37\x8b"
+DATA ·templatesData+736(SB)/16,$"\xc5\x52\xf6\x62\x73\x29\x2e\x24\xc8\xdd\x5a\xd6\xb5\xac\xf3\x5c"
+DATA ·templatesData+752(SB)/16,$"\xed\xf6\xda\x38\x28\xf2\x6c\xb6\x3e\x3a\x69\x67\x79\x36\xdb\xe8"
+DATA ·templatesData+768(SB)/16,$"\xdd\xde\x48\x6b\x17\x17\xff\x52\x7b\x1c\x90\xc6\x68\x43\xaf\x94"
+DATA ·templatesData+784(SB)/16,$"\xe6\xbf\x17\x8d\x7f\x5c\x28\x7d\x70\xaa\xc5\x87\x4e\xba\xc5\xd6"
+DATA ·templatesData+800(SB)/16,$"\x39\x82\xd0\xf4\x7a\x2f\xdc\x36\xfc\xbb\x68\x54\x2b\xc3\x80\xd5"
+DATA ·templatesData+816(SB)/16,$"\xc6\xd1\xbf\xce\xa8\xee\x82\xe6\xda\x63\xb7\xc1\x7f\x9d\xda\x49"
+DATA ·templatesData+832(SB)/16,$"\xfc\xf7\xd0\x59\xd1\xc8\x59\x5e\xe6\xf9\x62\x01\x2f\x55\x2b\xcf"
+DATA ·templatesData+848(SB)/16,$"\x8f\xd6\xc9\x1d\xd4\xb2\x51\x9d\xb4\xe0\xb6\x32\x1d\x56\x9d\x93"
+DATA ·templatesData+864(SB)/16,$"\xa6\x11\x1b\x09\xa2\xab\x61\x7d\x50\x6d\x2d\x4d\xee\x8e\xfb\x3b"
+DATA ·templatesData+880(SB)/16,$"\x66\xdd\xe4\x19\x12\x5b\xf5\x2f\xf3\x3c\x5b\x2c\xe0\x7f\x44\x7b"
+DATA ·templatesData+896(SB)/16,$"\x09\xd7\xa2\xbd\xe4\x15\x90\x6a\x70\x46\x4a\x30\x5a\x3b\x59\x83"
+DATA ·templatesData+912(SB)/16,$"\x70\xf4\x6b\x0e\x1b\xd1\xb6\xaa\xbb\xa0\xb9\x2f\x3b\x68\xb4\x01"
+DATA ·templatesData+928(SB)/16,$"\x29\x36\x5b\x86\xd0\x86\x90\xd5\xca\xc8\x8d\xd3\xe6\x08\xaa\x23"
+DATA ·templatesData+944(SB)/16,$"\x74\x88\x69\x0e\xaa\xdb\xb4\x87\x1a\x81\x11\x55\x05\xcf\xda\x16"
+DATA ·templatesData+960(SB)/16,$"\x58\xc6\xe0\xb6\xc2\x81\x30\xca\x4a\xb8\x52\x56\x39\x9c\x84\x18"
+DATA ·templatesData+976(SB)/16,$"\x2d\xe1\x43\xd6\x02\x4e\x25\x2d\x08\x43\x14\x3a\x69\x64\x0d\xeb"
+DATA ·templatesData+992(SB)/16,$"\xa3\xa7\xa5\x82\xb7\x9e\x72\x9e\x81\xa3\xb2\x46\x12\x5a\xf9\x41"
+DATA ·templatesData+1008(SB)/16,$"\x6d\x44\x4b\xb8\xb4\xa9\xa5\xa9\xf2\x0c\x19\x2e\x90\x0e\x60\x6d"
+DATA ·templatesData+1024(SB)/16,$"\xcc\x03\x47\xf8\xe2\xe5\xa1\xdb\x94\x4c\x1b\x8b\xe7\x67\xbd\x3f"
+DATA ·templatesData+1040(SB)/16,$"\x82\x68\x5b\x8f\xde\x69\x70\xc2\x5c\x48\xd7\xb3\x9a\x67\x38\xa7"
+DATA ·templatesData+1056(SB)/16,$"\xf0\xc3\x01\xe7\x4e\xd7\x12\xb4\x25\x71\xff\xa2\x6b\x39\x40\xfa"
+DATA ·templatesData+1072(SB)/16,$"\xac\xae\x71\x1c\x44\x5d\x83\xf0\x22\xd7\xd1\x48\x79\x29\x56\x51"
+DATA ·templatesData+1088(SB)/16,$"\xe6\xa7\x16\x68\x49\x11\x79\x27\x76\x32\x3e\xb4\x7a\x23\xda\xf8"
+DATA ·templatesData+1104(SB)/16,$"\x64\xd5\xbf\x24\x6a\xfd\x2f\x8f\x89\x06\xb4\xae\xf8\xa8\x76\xf2"
+DATA ·templatesData+1120(SB)/16,$"\x2d\xda\x47\x98\xeb\xc4\x45\xfc\x1d\x7c\x00\xe5\xaa\x75\x3b\x87"
+DATA ·templatesData+1136(SB)/16,$"\x5a\x38\x01\xef\xde\xa3\x93\xcc\x71\x96\x9f\x19\xf8\x08\x6c\x68"
+DATA ·templatesData+1152(SB)/16,$"\x34\xbb\xfb\x32\x42\x93\xef\xcd\xca\x88\x7c\x04\xb3\x50\x55\xd5"
+DATA ·templatesData+1168(SB)/16,$"\x90\x10\x36\x62\xa3\x9c\x24\x91\x5e\xe3\x2f\xcb\xd4\x3b\x1d\x88"
+DATA ·templatesData+1184(SB)/16,$"\xc2\x55\xc8\x60\xf0\x09\x1f\x2a\x02\x7b\xd5\xf4\x36\x5f\x6b\x69"
+DATA ·templatesData+1200(SB)/16,$"\xa1\xd3\x0e\xe4\x07\x65\xdd\x3c\x41\xb9\x31\x52\x20\x4e\xe5\xe0"
+DATA ·templatesData+1216(SB)/16,$"\x5a\xb9\x2d\xec\xa5\xd9\x29\x6b\x95\xee\x2c\xfd\x7e\xc2\xe6\xe5"
+DATA ·templatesData+1232(SB)/16,$"\xb6\xd2\x5c\xa3\x1d\xf7\x90\xce\x1c\xba\x4d\x80\x5d\xcb\x46\x1b"
+DATA ·templatesData+1248(SB)/16,$"\x26\x50\x75\x17\x68\x88\x61\x5e\x11\xa8\x8a\xac\x0f\x84\x8f\x6b"
+DATA ·templatesData+1264(SB)/16,$"\xdc\x69\x4a\xff\xb0\xf2\xef\x24\xb5\x83\x95\xa0\x3b\xa8\x95\xbd"
+DATA ·templatesData+1280(SB)/16,$"\x84\x0d\x1a\xad\xea\xac\x93\xa2\x06\xdd\xf4\x0a\x21\xbc\x05\xba"
+DATA ·templatesData+1296(SB)/16,$"\x6e\x2d\xaf\x64\xab\xf7\x3b\xd9\xb9\x32\xcf\x02\x96\x02\x75\x5f"
+DATA ·templatesData+1312(SB)/16,$"\xe6\xb7\x14\x83\xce\x2f\xd5\xfe\xb9\x32\xa0\x2c\x22\xaf\x41\x58"
+DATA ·templatesData+1328(SB)/16,$"\x10\x60\xa4\x3b\x98\x0e\xae\x44\x7b\x90\xd0\x18\xbd\x8b\x6e\x43"
+DATA ·templatesData+1344(SB)/16,$"\xce\xa1\xba\x5a\x21\xc7\xe4\xd7\x88\x04\xe5\xdb\x47\x05\xd6\x83"
+DATA ·templatesData+1360(SB)/16,$"\x8f\x0d\x18\x50\x10\xb9\xd3\xb0\x96\x60\x2f\xd5\x7e\x2f\xeb\x0a"
+DATA ·templatesData+1376(SB)/16,$"\x5e\x39\x50\xac\x09\x5e\x4b\xd6\x88\x07\x17\xef\x98\x71\xd4\xa3"
+DATA ·templatesData+1392(SB)/16,$"\xe8\x8e\xd0\x1c\xba\x8d\x53\xba\xab\xf2\x2b\x61\x22\xb5\x2b\x08"
+DATA ·templatesData+1408(SB)/16,$"\x81\xb7\xf2\x43\xc4\x4c\xa0\x92\x16\xc4\xc0\x84\x8e\xa0\xbd\xfe"
+DATA ·templatesData+1424(SB)/16,$"\x3d\x22\xa2\x48\xd6\x93\xd0\x96\xf8\xfa\x62\xc1\x61\x8a\xad\x09"
+DATA ·templatesData+1440(SB)/16,$"\x91\x72\xf0\xc1\xf5\x40\x98\x8b\x03\x0a\x14\x36\xba\x73\x42\x75"
+DATA ·templatesData+1456(SB)/16,$"\xbc\x52\x1c\x75\x9a\x00\x88\x15\x44\xb4\x37\xb2\x51\x1f\x9e\x70"
+DATA ·templatesData+1472(SB)/16,$"\x08\x54\x76\x0e\xaa\xe1\x09\xca\x06\x4a\xc8\xde\x66\xb5\x32\xb3"
+DATA ·templatesData+1488(SB)/16,$"\x39\x5c\x6f\xd5\x66\x8b\xef\xc4\x90\x1e\xbf\x18\x06\xce\x68\xcc"
+DATA ·templatesData+1504(SB)/16,$"\x33\x31\x9b\xd3\x13\x86\xb6\x9e\xbf\x6b\xd5\xb6\x28\xeb\x14\x7b"
+DATA ·templatesData+1520(SB)/16,$"\x20\x0f\x51\xe1\x4a\x0b\x31\x63\x96\x54\xd7\xe8\xf8\x36\x88\xcd"
+DATA ·templatesData+1536(SB)/16,$"\x9b\xe1\x2b\x7c\x87\x62\xc2\x31\x56\x2a\x49\x3c\x5f\x2c\xf2\xe8"
+DATA ·templatesData+1552(SB)/16,$"\x57\x14\x89\x91\xdc\xbd\xd1\xeb\x56\xee\x88\x18\x22\x53\xf7\x94"
+DATA ·templatesData+1568(SB)/16,$"\xa6\xd2\xed\xdd\x14\x91\x11\x03\x88\x4d\x75\x1b\xbd\x43\x38\xd6"
+DATA ·templatesData+1584(SB)/16,$"\x3e\x31\x51\x4b\xbb\x31\x6a\x2d\x09\x51\xc0\x8f\xbb\xc5\x48\x9f"
+DATA ·templatesData+1600(SB)/16,$"\x1d\xd4\x72\xa3\x6a\x09\x5b\x7d\x4d\xe6\xa8\x61\x2b\xba\xba\x65"
+DATA ·templatesData+1616(SB)/16,$"\x03\xf5\x18\x0b\x04\xe4\x7d\x10\x71\xa3\xe9\x21\x7e\xd9\xa1\xa9"
+DATA ·templatesData+1632(SB)/16,$"\x12\xb1\x22\x89\xf7\x65\x05\xaf\xba\x40\xdb\x46\x58\x32\xa3\x60"
+DATA ·templatesData+1648(SB)/16,$"\x9b\x2c\xf5\xa1\xe8\x82\xd4\x3b\xd5\x56\xf0\xaa\x9f\x8b\x32\x0d"
+DATA ·templatesData+1664(SB)/16,$"\x26\x3e\x67\x83\xd0\x1b\x69\x2d\xb2\x6a\x9d\xde\x5b\xd6\x83\xd5"
+DATA ·templatesData+1680(SB)/16,$"\xad\x04\xf9\x61\x23\xf7\xc4\x93\xb2\x70\xbd\x95\xdd\x90\x51\x46"
+DATA ·templatesData+1696(SB)/16,$"\xc3\x2a\xb2\x7b\xb9\x51\xa2\x25\x53\x25\x2f\xf5\x6e\x50\xc5\x70"
+DATA ·templatesData+1712(SB)/16,$"\x37\x86\xf2\x13\x18\xaf\xea\xae\x34\xee\x9e\xba\x4b\x0d\x6d\x1e"
+DATA ·templatesData+1728(SB)/16,$"\x7c\x88\xfc\xd4\x0e\xdd\xfa\xcf\x96\x8c\x50\x76\xce\x82\xec\x9c"
+DATA ·templatesData+1744(SB)/16,$"\x32\xb2\x3d\x7e\x76\x35\x44\x38\x5d\xb0\xd3\xdd\x0f\x11\x2f\x59"
+DATA ·templatesData+1760(SB)/16,$"\xc8\x7c\xbc\xac\x91\x3b\x6f\xee\xbc\x25\xab\x5e\x19\xbd\x27\x44"
+DATA ·templatesData+1776(SB)/16,$"\x1c\x15\xa7\x44\xd1\xfd\x91\x9c\xe1\x0e\x44\xca\x0a\x46\x3d\x47"
+DATA ·templatesData+1792(SB)/16,$"\xd5\xb0\x7a\x62\x98\x5d\x2c\xe2\x6b\xce\xa7\x3a\xc1\xe9\x80\xd7"
+DATA ·templatesData+1808(SB)/16,$"\x73\xc7\x1b\x5d\x94\x41\x9f\x84\xf5\x20\x21\x05\x4b\xfc\x07\xf3"
+DATA ·templatesData+1824(SB)/16,$"\x86\xb0\xe7\x16\x25\xed\xba\x80\xbe\x83\x5c\x2a\x1b\x50\x86\x19"
+DATA ·templatesData+1840(SB)/16,$"\x79\xf6\x56\x5c\x14\xa5\xa7\x1a\xe8\xcf\x62\x01\x2f\x70\x0f\x0f"
+DATA ·templatesData+1856(SB)/16,$"\x8e\x38\xa4\x22\xfb\xc5\xef\xf7\x3d\xd4\x62\x01\x38\x48\xf4\x21"
+DATA ·templatesData+1872(SB)/16,$"\xd0\x08\xe0\x9c\x66\xa5\x8b\x2c\x16\xc3\x39\x20\xac\x7f\x99\x67"
+DATA ·templatesData+1888(SB)/16,$"\x7f\xc3\xf4\xb9\x28\xfd\x36\x05\x77\xcc\xa6\x77\xc2\x18\x71\xcc"
+DATA ·templatesData+1904(SB)/16,$"\xb3\x37\xe2\x7a\x30\x9f\x20\x8c\xb8\xa6\x49\x9e\x6d\x45\x0a\x35"
+DATA ·templatesData+1920(SB)/16,$"\x52\xd4\xba\x6b\x8f\xb0\x93\x3b\x0c\x73\xbc\x21\xbd\xfa\xf5\xe5"
+DATA ·templatesData+1936(SB)/16,$"\xf9\x6f\x46\x5f\xa9\x5a\x92\xf3\xa8\xdd\xbe\x95\xe8\x62\x1c\x34"
+DATA ·templatesData+1952(SB)/16,$"\x44\x9a\xf8\x92\xcf\xee\x79\x32\x6d\x1d\x94\xc3\x57\x2f\xcf\xe7"
+DATA ·templatesData+1968(SB)/16,$"\x60\x0f\x9b\xad\xdf\x52\x28\xa4\x75\x32\xba\x21\xa2\x79\x2d\xaf"
+DATA ·templatesData+1984(SB)/16,$"\x59\x83\xc3\xe5\x52\x2d\x7a\x5a\x06\x6e\x47\x9c\x73\xb2\x03\x62"
+DATA ·templatesData+2000(SB)/16,$"\xb4\x22\xbe\x67\x5f\x8c\x0b\x89\xd6\x6a\x42\x14\x99\xb0\xd0\xd8"
+DATA ·templatesData+2016(SB)/16,$"\xea\xdc\x09\x87\x10\x8d\xad\xde\x48\x41\xa9\x5f\xf2\xf8\x5c\x19"
+DATA ·templatesData+2032(SB)/16,$"\xff\xf4\x9f\xad\x5e\xbf\x3c\x67\xdb\xb3\xd5\xf9\x61\xfd\xf2\xbc"
+DATA ·templatesData+2048(SB)/16,$"\xca\x33\x24\xaa\x28\x81\x56\xf5\x32\xfb\xbb\xea\x2e\x9f\xd5\xf7"
+DATA ·templatesData+2064(SB)/16,$"\x15\x98\x75\xda\x48\x0b\xf6\xb8\x5b\xeb\x56\x6d\xa0\x55\xdd\xa5"
+DATA ·templatesData+2080(SB)/16,$"\x25\xdf\x47\xa1\x7d\x56\x62\xc9\x62\x23\x71\x3d\xab\x6b\x7c\xe9"
+DATA ·templatesData+2096(SB)/16,$"\x13\xc5\x01\xfe\x3b\x32\xc6\x79\x48\xb4\x79\xe7\x21\x2c\x71\x1a"
+DATA ·templatesData+2112(SB)/16,$"\x39\xb0\xde\xcb\x8e\xb3\x89\x7d\x2b\x36\x71\x37\x47\x9c\x79\xe6"
+DATA ·templatesData+2128(SB)/16,$"\x17\xfc\x44\xb2\x39\x4d\xd8\xfb\x6c\x33\xf8\x3e\xcb\xf0\x45\xb7"
+DATA ·templatesData+2144(SB)/16,$"\xd1\x78\x7a\xf9\x62\x39\xc6\x00\x45\xc9\x61\x08\x51\x9c\x21\x20"
+DATA ·templatesData+2160(SB)/16,$"\x42\x3b\xbf\x9f\x5c\x47\x04\x4c\x65\x1b\x26\xa0\x7c\x19\x57\xf4"
+DATA ·templatesData+2176(SB)/16,$"\x41\xdc\x9c\xc6\xc1\x84\x77\xfe\x64\x9a\xa7\x87\x85\x1c\x70\x51"
+DATA ·templatesData+2192(SB)/16,$"\x9e\x28\x3f\x08\x64\x16\xd6\xa6\x9c\x83\x95\xe6\x4a\xd6\xa8\xb0"
+DATA ·templatesData+2208(SB)/16,$"\x4d\xab\x08\x3b\x1f\xdf\x36\xb8\x39\x81\x72\x79\x96\xd0\x32\x14"
+DATA ·templatesData+2224(SB)/16,$"\x7d\xc4\x7a\x22\xc5\x3d\x2d\x6d\x0e\x9e\x77\x08\x9b\x5e\xf6\xac"
+DATA ·templatesData+2240(SB)/16,$"\x91\xbc\xc9\x14\x46\x02\x1e\xca\x6f\x1a\x8f\xc3\x1b\x0e\x64\x7d"
+DATA ·templatesData+2256(SB)/16,$"\x98\xfc\x79\x88\xa6\xf7\x70\x65\xfb\xc5\xe6\x60\x77\x98\x49\x59"
+DATA ·templatesData+2272(SB)/16,$"\x07\x8d\x32\xd6\x85\x30\xd5\x39\x79\x61\x94\x3b\x9e\x4b\xe7\xbe"
+DATA ·templatesData+2288(SB)/16,$"\xcc\x60\x68\xff\x3e\xac\x8d\xb4\xfa\x60\x36\x3e\xfb\xf1\xd8\x90"
+DATA ·templatesData+2304(SB)/16,$"\x5d\x32\xa8\x7b\x1a\xcd\x84\x8c\x91\xd9\x9c\x4b\x17\xa7\x80\x95"
+DATA ·templatesData+2320(SB)/16,$"\x6e\xb2\xfa\x70\x69\xe1\x77\x63\x01\x76\x8f\x48\xac\xdc\x0b\x23"
+DATA ·templatesData+2336(SB)/16,$"\x1c\xee\x4c\x8b\x05\xb4\xca\x3a\x9c\xb5\x15\x76\x2b\x6d\x24\xd0"
+DATA ·templatesData+2352(SB)/16,$"\x6e\xc5\xbf\xff\xc7\xe3\x1f\x7e\x5a\x0b\x2b\xff\xf2\x18\x6a\x75"
+DATA ·templatesData+2368(SB)/16,$"\x21\xad\x7b\x9a\x67\xe9\xda\xe3\xdd\x38\x92\x34\x38\xe6\x8d\x44"
+DATA ·templatesData+2384(SB)/16,$"\xfb\x25\xc6\xc1\x69\xee\x69\xce\x26\xb1\x2d\x85\x1f\x87\xf5\x91"
+DATA ·templatesData+2400(SB)/16,$"\x98\x2b\x78\x66\xad\x34\x0e\x94\x03\xdd\x0d\xa0\x09\xad\xdf\x95"
+DATA ·templatesData+2416(SB)/16,$"\x07\x29\x33\x1e\x98\x30\xc6\xfb\x9c\xd1\x87\x30\x96\xac\xd3\xb4"
+DATA ·templatesData+2432(SB)/16,$"\xef\xf9\x0c\xd2\x13\x58\x8d\x94\x39\x35\xe1\x5e\x90\xe9\x3e\x7f"
+DATA ·templatesData+2448(SB)/16,$"\x7e\x97\x1e\xa7\x89\xc2\x6d\xce\x6b\x78\x4f\x32\x87\x8d\x43\xbc"
+DATA ·templatesData+2464(SB)/16,$"\x14\x2c\xf9\x4f\xd8\xf2\xa9\xb8\xc0\x7f\x28\x4c\xe6\x59\x88\x9a"
+DATA ·templatesData+2480(SB)/16,$"\xfd\x08\x9f\xe1\x07\x60\xca\x62\x76\x49\x7f\x30\xcb\xc9\xb3\x51"
+DATA ·templatesData+2496(SB)/16,$"\xb1\x21\xcf\x62\x69\xa2\x07\xc2\xc4\x66\xb4\x7c\xcf\x47\x1c\xa2"
+DATA ·templatesData+2512(SB)/16,$"\x0d\x64\x38\x8b\x02\x0b\xff\xe1\xf0\x92\x67\x21\xf8\x58\x1c\x0a"
+DATA ·templatesData+2528(SB)/16,$"\x0f\x79\x66\x9d\x19\x2f\x61\x0f\xeb\x97\x14\xb4\x71\x66\x9f\xa9"
+DATA ·templatesData+2544(SB)/16,$"\xb1\xe1\xfe\x5d\x6f\x2e\x01\xeb\x76\xd5\x2f\x07\x27\x3f\x84\x61"
+DATA ·templatesData+2560(SB)/16,$"\x9c\x0c\x3b\xb1\x7f\xc7\x8f\xff\x2d\x8f\x3e\x90\x78\xa3\x0d\x0b"
+DATA ·templatesData+2576(SB)/16,$"\xde\x37\x2e\x8b\x71\x54\x26\xed\xa4\x01\x34\xd5\xd0\x80\x6d\xcf"
+DATA ·templatesData+2592(SB)/16,$"\x30\xaf\x8b\xd5\xcc\x17\x27\xd6\xf6\x68\x91\x82\x64\x69\x2e\xba"
+DATA ·templatesData+2608(SB)/16,$"\x6d\x74\x67\xdd\x10\x72\x05\x33\xae\x8b\x22\xce\x5f\xc4\x07\xdc"
+DATA ·templatesData+2624(SB)/16,$"\x56\x6d\x38\x0f\x76\x87\xdd\x5a\x1a\x44\x45\xa9\x02\x34\xba\x6d"
+DATA ·templatesData+2640(SB)/16,$"\xf5\xb5\xac\x01\x8d\xaf\xbd\xa2\x2d\x89\xb7\x5d\x5f\xfe\xb8\x50"
+DATA ·templatesData+2656(SB)/16,$"\x34\x78\xd8\xfb\xa5\x22\xc2\x15\x3c\x7e\x94\xd3\x49\x5e\x1a\xf3"
+DATA ·templatesData+2672(SB)/16,$"\x56\xeb\x5f\x44\x77\x0c\x6f\xb8\x56\x58\xbd\x96\xd7\xc5\xcc\x69"
+DATA ·templatesData+2688(SB)/16,$"\x0d\x3b\x3c\xfe\xd3\x82\xb3\x32\x31\x5e\x9b\xc8\x86\x02\x12\x04"
+DATA ·templatesData+2704(SB)/16,$"\xcd\xb0\x90\xde\x3f\xc0\x59\xa9\x89\xb2\xf5\x89\x58\xc7\xa2\x67"
+DATA ·templatesData+2720(SB)/16,$"\x96\xde\x6b\x79\x1d\x2b\x3f\x02\x3a\x79\x3d\x89\xd9\xad\x16\xb5"
+DATA ·templatesData+2736(SB)/16,$"\xed\x73\x12\x2f\xdd\x2a\xc7\x23\x06\x82\x17\x1b\x7d\xe8\x1c\xa8"
+DATA ·templatesData+2752(SB)/16,$"\xce\x95\x29\xec\x4d\x9e\xf9\x22\xca\x19\x11\x7d\x83\xa4\x2e\x61"
+DATA ·templatesData+2768(SB)/16,$"\x27\x2e\x65\x31\xa6\x15\xeb\x72\x87\xce\x95\xb7\x48\x14\xe1\x2d"
+DATA ·templatesData+2784(SB)/16,$"\x1a\x0b\xf4\xca\x96\xf0\xeb\x5e\x76\x45\x62\x04\x25\x50\x25\x09"
+DATA ·templatesData+2800(SB)/16,$"\x62\x9d\x77\x70\x9a\x09\x06\xb3\xe2\xa3\xfa\xcf\xad\x14\x5d\x31"
+DATA ·templatesData+2816(SB)/16,$"\x5b\xcc\xe0\x21\x29\xa8\xcc\xf3\x4c\x35\xd0\xcc\x41\xc2\x72\x85"
+DATA ·templatesData+2832(SB)/16,$"\x39\x64\xab\xf5\xe5\x61\x4f\x0b\x94\x4f\x40\xc2\x9f\x56\xd0\xa9"
+DATA ·templatesData+2848(SB)/16,$"\x16\xf1\x64\x88\x76\x05\x32\xcf\x6e\x41\xb6\x56\x82\x6a\x18\x00"
+DATA ·templatesData+2864(SB)/16,$"\xe5\x7a\x76\x06\xad\xec\x8a\x86\x1f\x4b\x78\x0a\x8f\x08\xa6\x89"
+DATA ·templatesData+2880(SB)/16,$"\x04\xad\x30\x26\xfe\xba\x4f\x26\x45\x44\x38\xd1\xe0\xfa\x67\x18"
+DATA ·templatesData+2896(SB)/16,$"\x0a\xa5\xb9\x41\xa8\x25\x52\xd5\xca\xee\xc2\x6d\x97\xd0\x54\x18"
+DATA ·templatesData+2912(SB)/16,$"\x85\x6e\xf3\x2c\xf3\x8b\x2a\xeb\xde\x21\x8d\xef\x91\xc0\x86\x30"
+DATA ·templatesData+2928(SB)/16,$"\xe0\x8e\xf4\x2b\x87\x56\xb7\x35\xfa\x70\x81\x4e\x85\xf6\x32\x87"
+DATA ·templatesData+2944(SB)/16,$"\x4b\x29\xf7\xb1\x6a\xe1\xe3\x2f\x42\x98\x2a\x15\xce\xdf\x84\x95"
+DATA ·templatesData+2960(SB)/16,$"\xcc\x78\x9e\x65\xb7\x9e\x78\x58\x81\xc9\xb3\xdb\xa0\x3e\x6f\x27"
+DATA ·templatesData+2976(SB)/16,$"\x2c\xa5\xc1\x51\x40\x76\x0e\x0f\xaf\xda\xf0\x22\xec\x13\x68\xf5"
+DATA ·templatesData+2992(SB)/16,$"\x9c\x4e\x27\xe5\x70\x1a\x00\xa7\xf3\xc5\x62\x50\xe7\xf6\x15\x32"
+DATA ·templatesData+3008(SB)/16,$"\x04\x9f\xaa\x3d\xd1\x4b\xaf\xf8\x07\x51\xbc\x5e\xd7\xb8\x3c\xa3"
+DATA ·templatesData+3024(SB)/16,$"\x5f\xae\xe0\xd1\x13\xff\xfb\xa7\x55\xf4\x38\x3f\xf4\xf0\x21\x6b"
+DATA ·templatesData+3040(SB)/16,$"\x67\x0e\xfa\x32\x28\x3e\x8a\x34\xcf\xb3\xcc\x5e\x2b\xb7\xd9\xd2"
+DATA ·templatesData+3056(SB)/16,$"\x24\xae\x76\x5c\x26\x2a\x56\xdd\x65\x09\xab\x15\x3c\x5a\x92\x10"
+DATA ·templatesData+3072(SB)/16,$"\xd9\xae\x9b\x39\x1a\x4a\x3f\x9f\xde\x79\xf1\x32\x4c\x9e\x65\xb5"
+DATA ·templatesData+3088(SB)/16,$"\x6c\xc4\xa1\x75\xf4\xae\x56\xa4\x73\x12\xfd\x73\x65\xa2\xe4\x89"
+DATA ·templatesData+3104(SB)/16,$"\x09\xa6\xac\x27\xac\x56\xe6\xfd\x13\xf8\x13\x93\x81\x90\x7f\x5a"
+DATA ·templatesData+3120(SB)/16,$"\xc1\x6c\x31\x7b\x72\x72\x22\x9b\x03\x2d\x90\xe0\xaf\x95\x21\xf4"
+DATA ·templatesData+3136(SB)/16,$"\xb7\xc8\x1f\xda\x11\x62\xfb\xf8\x71\xc2\x94\x87\xf6\x6c\x75\xaa"
+DATA ·templatesData+3152(SB)/16,$"\x9d\xa3\xe5\xbe\x30\xe6\xb5\x76\x2f\xb0\x4c\xdc\x23\x49\xad\xe7"
+DATA ·templatesData+3168(SB)/16,$"\xbf\xb4\x0a\x78\xe6\x5e\x43\xb6\x7a\x6b\xd4\xee\x37\xaa\xf8\x11"
+DATA ·templatesData+3184(SB)/16,$"\x7b\x73\x24\xbd\xf4\xe6\x85\x28\xd2\x45\x46\x11\xd0\x5b\x1a\xd5"
+DATA ·templatesData+3200(SB)/16,$"\xf2\x8c\xdc\x1c\x8c\x55\x57\xb2\x3d\x86\x22\x95\xf5\xe5\xb2\xe1"
+DATA ·templatesData+3216(SB)/16,$"\x05\x4d\x35\x35\x1c\x7c\x51\x34\x9f\x2a\x7e\x4c\x6e\x42\x8a\x61"
+DATA ·templatesData+3232(SB)/16,$"\x00\x41\x51\x21\x48\xf5\x0a\xb7\xf4\xa2\x64\x7f\x65\xca\x19\x96"
+DATA ·templatesData+3248(SB)/16,$"\xf1\x33\x62\x32\x83\x92\xd9\x6b\x50\xc1\x04\xca\xa6\x5a\xe6\x99"
+DATA ·templatesData+3264(SB)/16,$"\x34\xe6\x47\x1c\x3d\x05\x28\x0d\x6a\x88\x4b\x89\x48\x02\xc7\x1e"
+DATA ·templatesData+3280(SB)/16,$"\x26\x10\x36\xa2\xfb\xb3\xe3\x9f\xbe\x3e\xa7\x6c\x5a\xf1\x41\x38"
+DATA ·templatesData+3296(SB)/16,$"\x42\xce\x50\xb0\x93\xa2\xb3\x81\xb7\x6b\xd1\x79\x58\xa7\xa9\xaa"
+DATA ·templatesData+3312(SB)/16,$"\x34\x02\x07\x6d\xa8\xfa\x16\xaa\x95\x8c\xee\xed\x56\x1a\xda\xc0"
+DATA ·templatesData+3328(SB)/16,$"\xa8\x4a\xab\x3b\x3a\x6d\x22\x61\xa2\xab\x79\x2d\x65\x91\xa8\x9e"
+DATA ·templatesData+3344(SB)/16,$"\x48\xaa\xfa\xb1\x68\x2a\x12\x5c\xcf\x06\x9a\x5a\x4a\x1f\x0a\x91"
+DATA ·templatesData+3360(SB)/16,$"\xd7\x20\x1d\x4a\xf3\x67\x0b\x6b\xb9\x15\x57\x8a\x8b\x84\xb8\xb3"
+DATA ·templatesData+3376(SB)/16,$"\x18\x4d\x25\xdb\xf5\xd1\xd7\xc0\xfa\x2a\x7c\x52\x19\xe6\x5a\x67"
+DATA ·templatesData+3392(SB)/16,$"\xcd\xe8\x92\xeb\x31\xfe\x17\x76\xe2\x08\xea\xa2\xd3\x46\x46\xd2"
+DATA ·templatesData+3408(SB)/16,$"\x7b\xab\xab\x18\xea\x55\x13\x66\x8f\xea\x75\x73\x50\x7d\x29\x93"
+DATA ·templatesData+3424(SB)/16,$"\x6b\xa8\x91\x1c\xa6\xda\x63\x38\xd7\x2c\x00\xbb\xd5\x87\x36\xae"
+DATA ·templatesData+3440(SB)/16,$"\x70\xbd\x15\x4e\x5e\x49\x33\xc2\x5e\xf5\xf6\x83\x12\xf1\xb6\xa2"
+DATA ·templatesData+3456(SB)/16,$"\x0d\xfc\x3e\xf7\x61\x74\xb9\x02\x23\xba\x0b\x89\x81\x3f\x24\x67"
+DATA ·templatesData+3472(SB)/16,$"\x61\x3b\x21\xa7\x5b\x0e\xbc\x8e\x8d\x88\x40\xab\xd7\x62\x27\x8b"
+DATA ·templatesData+3488(SB)/16,$"\xb2\xf4\x93\x29\x73\x5e\xae\xfc\xbb\x68\x85\x7e\x2f\x6b\x6c\xc5"
+DATA ·templatesData+3504(SB)/16,$"\xee\xe1\xd1\xce\xa1\x19\x79\x45\xc9\x5b\x4e\xa2\xc6\x9b\x10\x3d"
+DATA ·templatesData+3520(SB)/16,$"\xc2\xd4\xe8\x16\x1f\x3f\x86\x79\x5e\x7a\x83\x48\xc2\x51\x63\xe4"
+DATA ·templatesData+3536(SB)/16,$"\xf9\xde\xc9\xbf\xdb\x75\xec\x77\xbe\x8d\xfd\x8e\x97\xb1\xfd\x5d"
+DATA ·templatesData+3552(SB)/16,$"\xec\x24\x42\xdd\xe3\x6e\x76\x1a\x91\x38\x62\x0c\x77\x2f\xc4\xe1"
+DATA ·templatesData+3568(SB)/16,$"\x77\x89\x3e\x5f\xf1\x81\x86\xe5\x77\x22\x9a\x0f\xd3\x90\xa1\x5d"
+DATA ·templatesData+3584(SB)/16,$"\x30\x90\x1a\xda\xc3\x6d\x74\xec\xd5\x50\xd3\x0c\x4b\x9b\xe0\x50"
+DATA ·templatesData+3600(SB)/16,$"\xc3\x13\x8e\xbf\xe0\xee\x18\x31\xd3\xab\x15\xcf\x38\x83\x47\x7f"
+DATA ·templatesData+3616(SB)/16,$"\xfd\xeb\x5f\xf1\x14\x62\xe8\x79\x49\x79\x15\x02\x20\x19\x1f\xa1"
+DATA ·templatesData+3632(SB)/16,$"\x28\xc2\xb4\xc7\x8f\x1f\x97\xf0\xf4\x29\xfc\x5b\x09\x1f\x09\x36"
+DATA ·templatesData+3648(SB)/16,$"\x8f\x5b\xb5\xad\x48\xe6\xb3\xc5\x6c\xfe\xe5\xb5\x71\xe2\x95\x89"
+DATA ·templatesData+3664(SB)/16,$"\xff\x0d\xc1\x96\xc9\xe5\x1b\xb9\x23\xbf\xe3\xbb\x5b\xef\x3f\x93"
+DATA ·templatesData+3680(SB)/16,$"\xed\x23\x90\x82\xb4\x5f\xd6\xca\x3c\x6b\xdb\xa2\xc7\x49\xfb\x24"
+DATA ·templatesData+3696(SB)/16,$"\x92\xcc\x5b\x25\xe3\xa0\x53\xdf\x68\x3f\xa1\xed\xf6\x09\x6d\xe1"
+DATA ·templatesData+3712(SB)/16,$"\xbc\x81\x87\xbc\x33\x33\x92\xb7\xd4\x01\x79\x6f\x64\x5b\xc4\x07"
+DATA ·templatesData+3728(SB)/16,$"\x24\x26\x3e\xbc\x34\x7a\x77\xde\x0a\xbb\x25\x51\x94\xe5\x1c\x4e"
+DATA ·templatesData+3744(SB)/16,$"\xbc\xa2\x25\x4a\xef\xff\xde\x00\x62\x48\xc8\x62\x92\x1b\x19\x1a"
+DATA ·templatesData+3760(SB)/16,$"\x2c\xd5\x73\x57\x0e\xd8\xcb\x6e\x3f\x8b\xf0\xfc\xb8\xc3\xa5\x0b"
+DATA ·templatesData+3776(SB)/16,$"\xe2\x29\xc1\x13\xa1\xfb\x60\x1a\xe4\xd5\xe7\xdd\xec\x1f\x94\x78"
+DATA ·templatesData+3792(SB)/16,$"\x27\x0a\x19\x2f\x56\xcb\x46\xf2\xd5\x40\xf5\x73\xab\xad\x2c\x08"
+DATA ·templatesData+3808(SB)/16,$"\x79\xbf\xd6\x73\x35\x94\xe4\x88\xa3\xfc\x24\xff\x03\xf0\xa9\x4e"
+DATA ·templatesData+3824(SB)/16,$"\xf1\xd8\xa7\x0f\x0e\x1e\x78\xab\x3f\x4d\x99\x3e\xb8\xe4\x00\xf1"
+DATA ·templatesData+3840(SB)/16,$"\x33\x1d\xce\x46\x4b\x07\x0b\x19\x83\xfe\x1e\x00\x95\xae\xc8\xe5"
+DATA ·templatesData+3856(SB)/16,$"\x08\x17\x6f\x01\x1e\x75\xe4\xf6\x2e\x25\xe0\x9a\x5b\xa7\x76\xd2"
+DATA ·templatesData+3872(SB)/16,$"\x0e\xec\x93\x8c\xf0\x17\x5d\xbf\x55\xb8\xdb\x8c\x9f\xcb\x1e\x74"
+DATA ·templatesData+3888(SB)/16,$"\xa7\xeb\x01\xe0\x40\xed\x44\x78\xaa\xbc\xdb\x70\x0d\x1f\x9a\x45"
+DATA ·templatesData+3904(SB)/16,$"\x9e\xd5\xb5\x4d\x9a\x2c\x46\xc5\x29\xca\x4b\x78\xc7\x14\x2d\x9e"
+DATA ·templatesData+3920(SB)/16,$"\x9d\x8e\xdc\xc8\x10\xae\x18\xe3\xad\xe3\x34\x04\x7d\x43\x8b\x49"
+DATA ·templatesData+3936(SB)/16,$"\x5f\x00\xfa\xbe\x3d\x26\xc3\x20\x4f\x51\xf6\xf7\x71\x88\x47\x72"
+DATA ·templatesData+3952(SB)/16,$"\xdf\x3f\x01\x1f\xe1\xfb\x20\xf2\xc2\x18\x9f\x9b\xc7\xbc\x93\x0e"
+DATA ·templatesData+3968(SB)/16,$"\xda\x38\x0b\xb9\x5a\xfa\x92\x0f\xfe\x9e\xe7\x19\x57\x03\xfc\x20"
+DATA ·templatesData+3984(SB)/16,$"\xfd\xc6\x41\xe4\x31\x4c\xc4\xdf\x38\xe6\x59\xa5\x61\xff\x9b\x86"
+DATA ·templatesData+4000(SB)/16,$"\x3d\xcb\x38\x1e\x7e\xcf\x29\x2e\x5e\x04\x0c\x28\x05\x1c\xea\x05"
+DATA ·templatesData+4016(SB)/16,$"\xb0\x4c\x84\x81\x6f\x50\x12\x61\x36\xfe\x26\x1a\x9c\x59\x26\xf5"
+DATA ·templatesData+4032(SB)/16,$"\xa9\x39\x33\xa4\x06\x55\x1b\xca\x84\x2a\x91\x54\xdd\xd3\xda\x0d"
+DATA ·templatesData+4048(SB)/16,$"\x4b\x38\x64\xe0\xa9\xdc\x70\x67\x63\x64\x8d\xad\xfa\xfa\x87\xcf"
+DATA ·templatesData+4064(SB)/16,$"\x44\x5f\xeb\x6b\xba\xa9\x69\x78\x14\xcd\x8a\x36\x7c\x34\xa6\xff"
+DATA ·templatesData+4080(SB)/16,$"\x3d\x28\x43\x29\x66\x70\x11\x8f\xe1\xad\x4e\xda\x7b\xca\x27\x21"
+DATA ·templatesData+4096(SB)/16,$"\xff\x49\xc2\x4a\x2b\x9d\x2c\x3c\x15\xfd\x96\x70\x2a\x09\xea\x1b"
+DATA ·templatesData+4112(SB)/16,$"\x8b\x82\xd1\xf3\xd3\x77\x37\xfb\x3f\xa0\x21\x09\x6e\xbe\xc9\x5c"
+DATA ·templatesData+4128(SB)/16,$"\x53\x6d\xac\xc0\x99\x83\xcc\x93\xaa\xe4\x72\xc5\x75\xa2\xbe\x36"
+DATA ·templatesData+4144(SB)/16,$"\x49\x85\x11\xe2\xc0\x96\xa5\xcf\x9d\x95\xaf\xe1\x70\xde\x4c\xef"
+DATA ·templatesData+4160(SB)/16,$"\x68\xd1\x80\xe6\x9d\x7a\x9f\x1c\x93\xe5\xfb\xd3\xf6\x71\xc2\x69"
+DATA ·templatesData+4176(SB)/16,$"\xa6\x2e\x13\x1d\x86\x6a\xbc\x34\x84\x34\x0f\xdd\x25\x71\x96\x40"
+DATA ·templatesData+4192(SB)/16,$"\xc2\x12\xc2\xaf\xf9\xf8\xf8\xdb\xdb\x00\xdd\x18\x7a\x0b\x98\x5c"
+DATA ·templatesData+4208(SB)/16,$"\x19\x7e\x57\x43\xf8\xa6\xab\xc2\x69\x46\xfa\xf5\xca\xff\x8c\x06"
+DATA ·templatesData+4224(SB)/16,$"\x82\x02\xa2\x6c\x13\xd1\xa2\x60\x58\x01\x9c\x74\xc5\x70\xf1\xff"
+DATA ·templatesData+4240(SB)/16,$"\xd1\xc3\xbf\xea\xf2\x69\xaa\xba\x2f\xb9\x47\x9a\xaa\xa9\xb9\x43"
+DATA ·templatesData+4256(SB)/16,$"\x4d\xc3\x53\xc3\xb8\xe2\x93\x94\x3c\x2b\xb2\xfa\x64\xee\x99\xb6"
+DATA ·templatesData+4272(SB)/16,$"\x15\x6e\xee\x2f\x70\x99\x9b\x5f\xf7\x4b\x98\x59\xe9\x22\x2d\xb3"
+DATA ·templatesData+4288(SB)/16,$"\x39\xe0\xdb\xa5\x2f\xd8\xbc\x30\x66\xe9\xd1\xbf\xea\xae\x44\xab"
+DATA ·templatesData+4304(SB)/16,$"\xea\xdb\xc1\x09\xa4\xa9\x7a\x2e\x56\x3d\x47\xa7\x03\xe6\x77\xba"
+DATA ·templatesData+4320(SB)/16,$"\x02\x8e\xf7\x0c\x74\x85\xa5\x92\xfb\xa2\xe0\x56\x5b\x11\xaa\x9c"
+DATA ·templatesData+4336(SB)/16,$"\x1e\xe2\x3e\x0e\xf6\x35\x97\xc2\x7f\x9c\xc2\x46\xa5\xbe\x70\x52"
+DATA ·templatesData+4352(SB)/16,$"\xb8\x53\x87\xa2\xae\x03\xa9\x5f\xa0\x42\x5a\x90\xe0\x64\x5d\x04"
+DATA ·templatesData+4368(SB)/16,$"\xf8\x72\x5a\x46\xff\xda\x05\x89\xbd\xb1\xc5\xa4\xa9\x80\x3c\x95"
+DATA ·templatesData+4384(SB)/16,$"\x06\x7c\xe2\x2c\x9a\xfa\xf8\xa8\x25\x75\xa2\x0d\x1f\x38\xd2\xaa"
+DATA ·templatesData+4400(SB)/16,$"\x6d\x00\x29\xf3\xd3\xca\x62\x90\x44\x5d\xdc\xbe\xca\x95\x09\x8c"
+DATA ·templatesData+4416(SB)/16,$"\xa7\xfe\x76\x92\xa3\xb8\xf5\x21\x9e\xd7\x89\xdd\xb9\xb5\xdc\xcb"
+DATA ·templatesData+4432(SB)/16,$"\x8e\x9a\xc5\xb8\x79\xcc\x51\x8f\x21\x5f\xd4\x60\xcc\x1a\x2d\x96"
+DATA ·templatesData+4448(SB)/16,$"\x46\xd1\x3e\x8c\xf6\x35\x7e\x9e\x56\x62\xfc\xec\xb7\xb0\xb0\x83"
+DATA ·templatesData+4464(SB)/16,$"\xf5\x61\x36\x62\xf5\x2c\xbe\xaf\x92\xc0\x7b\x9b\x16\x0b\x06\x32"
+DATA ·templatesData+4480(SB)/16,$"\x64\xe4\xa7\xeb\x46\xe3\x68\xd9\x4f\x1e\xaa\x94\xea\x4b\x49\xa0"
+DATA ·templatesData+4496(SB)/16,$"\xb9\xdb\x68\x76\x97\xdc\x28\xca\xe6\xc2\xf8\xee\xb0\xd0\x10\x93"
+DATA ·templatesData+4512(SB)/16,$"\xa7\xc7\x9c\xe6\x84\xc6\xc8\xb0\x38\x15\x5a\xc1\x6c\x46\x8f\x31"
+DATA ·templatesData+4528(SB)/16,$"\x25\x59\x81\xd8\xa3\x4a\x8a\x7e\x6c\x3e\x95\x97\x17\x02\x7b\x5d"
+DATA ·templatesData+4544(SB)/16,$"\x98\x87\x9e\xf7\x23\x33\x65\xb5\x71\xd5\x79\xab\x36\x72\x88\x07"
+DATA ·templatesData+4560(SB)/16,$"\x2b\x12\x6a\x0e\xff\xe4\x5b\x34\xea\x91\x1b\xd4\xe4\x43\x75\x1d"
+DATA ·templatesData+4576(SB)/16,$"\x5b\xe9\x84\x49\x81\xdf\xa9\xf7\xbe\x00\x38\x4f\x4a\x87\xef\xfe"
+DATA ·templatesData+4592(SB)/16,$"\x19\x46\xa9\xc2\xff\xc3\x8f\x74\xfe\x3a\xb9\x4b\x4d\x8b\x53\x5f"
+DATA ·templatesData+4608(SB)/16,$"\xdd\xaf\x3d\x3e\xce\xd0\x45\xea\xa5\xa4\x9b\xde\x7d\xbc\x95\x47"
+DATA ·templatesData+4624(SB)/16,$"\x68\xc2\x14\x6e\x28\xfb\x6c\x8f\x11\xa3\xf4\xc8\x93\x4b\xbc\x42"
+DATA ·templatesData+4640(SB)/16,$"\xdf\x1f\x0b\xce\xbe\x82\x77\xd3\xd3\xb9\x23\xa7\x7c\x50\x3c\xf0"
+DATA ·templatesData+4656(SB)/16,$"\x8e\x5b\xf0\x87\x25\xd5\x6f\x9a\xba\x06\x8a\x33\x9a\x46\xb9\x22"
+DATA ·templatesData+4672(SB)/16,$"\x97\x7b\x91\x99\x90\x2b\xfd\xf3\x60\x1d\x18\xc9\xfd\x54\x48\x13"
+DATA ·templatesData+4688(SB)/16,$"\x93\x73\xd2\x9b\x83\x6a\x63\x5a\xd3\x54\x78\xd8\x8b\x16\x92\xee"
+DATA ·templatesData+4704(SB)/16,$"\x5d\x7e\x88\x72\xb7\xf8\x14\xcf\x8a\x71\x84\x4e\x96\x2b\xce\xab"
+DATA ·templatesData+4720(SB)/16,$"\x0a\xe4\x36\xd0\x8a\x2f\x93\xbd\x6b\x05\x8d\x68\xad\xa4\xe1\xfe"
+DATA ·templatesData+4736(SB)/16,$"\x6e\xdf\x17\xdc\xb2\xa6\x22\x75\xac\x38\x39\x65\xc4\xce\x84\xe7"
+DATA ·templatesData+4752(SB)/16,$"\x73\x67\x78\x4e\xbc\xd2\xaf\xf0\xaf\xa2\x4c\x46\x07\xb8\xfa\x79"
+DATA ·templatesData+4768(SB)/16,$"\xff\xe8\x5a\x3f\x33\x0d\xb9\x63\x79\x7c\x36\xe4\x84\x30\x49\x21"
+DATA ·templatesData+4784(SB)/16,$"\x26\x1e\x34\x27\x4c\x0f\x23\x10\xfe\x5d\xbd\xd6\xd7\x45\x59\xfd"
+DATA ·templatesData+4800(SB)/16,$"\xa3\x53\x1f\x0a\x7e\x1d\x8f\x8d\x31\x0f\xef\x4f\x8d\x81\xdb\x79"
+DATA ·templatesData+4816(SB)/16,$"\xa8\x2e\x7c\x6b\xda\x17\x09\xff\x7c\xea\x17\xa7\xde\xcb\xb1\xe2"
+DATA ·templatesData+4832(SB)/16,$"\xd7\x07\xdc\x23\x49\xdf\x20\xd0\x1e\x63\x63\xd4\xa1\x37\x49\x1b"
+DATA ·templatesData+4848(SB)/16,$"\xe8\x3d\x5b\x2f\xa7\x6b\xa5\x2d\x92\xe9\x55\xbc\xd2\x2f\xcf\xe9"
+DATA ·templatesData+4864(SB)/16,$"\xb2\xd9\x62\xb4\x9f\x53\x69\x7c\x89\xf7\x8a\xb7\xa1\x1f\x00\xfd"
+DATA ·templatesData+4880(SB)/16,$"\x9d\x2f\x8d\xfb\xa4\x28\xe0\x67\xf4\x25\x70\x68\xf1\x61\x21\x41"
+DATA ·templatesData+4896(SB)/16,$"\xdf\xd0\xed\x72\xc8\x7d\xd1\xca\xf9\x3a\x1b\x54\xe7\x9b\x5f\x1b"
+DATA ·templatesData+4912(SB)/16,$"\x6d\xc0\xc8\x8b\x43\x2b\x8c\xaf\xb8\x8f\x50\x23\x54\x51\xb2\x99"
+DATA ·templatesData+4928(SB)/16,$"\x0c\x30\xa3\x05\x79\xcc\x18\x6d\x08\x9a\x0b\xc4\x6b\xe5\x26\x68"
+DATA ·templatesData+4944(SB)/16,$"\x70\x4a\x51\xa6\xe1\x29\xe4\x56\xc9\x1e\x93\x54\x64\x75\x2d\x7f"
+DATA ·templatesData+4960(SB)/16,$"\xc3\x78\xf6\x31\xa9\x2d\x87\xc2\xf7\xa9\x1c\xea\x53\xa0\xbe\x78"
+DATA ·templatesData+4976(SB)/16,$"\x99\xdc\xa1\xa7\xf3\x7a\x1e\xb0\x72\x16\xd9\x50\x8d\xda\x08\x6a"
+DATA ·templatesData+4992(SB)/16,$"\x20\x47\x17\x38\xc1\x0e\xd7\xd9\xd8\x41\x08\xb2\x17\x0e\x8d\x91"
+DATA ·templatesData+5008(SB)/16,$"\xbb\x34\x71\xc3\x86\x47\xa1\xb0\x46\x45\x68\x10\xeb\xb5\x91\x57"
+DATA ·templatesData+5024(SB)/16,$"\x8a\x97\x40\x2d\xb0\x84\x42\x89\x7a\xbc\xa0\x1f\x8e\x5b\x51\x54"
+DATA ·templatesData+5040(SB)/16,$"\x03\x49\xcf\x63\xa6\xa8\x46\x6d\x29\xa8\x82\xd4\x60\xb8\xfa\x3e"
+DATA ·templatesData+5056(SB)/16,$"\xc2\xe9\x9b\xb4\x9b\xa1\x4e\x22\x66\x4f\x10\xcf\x0a\xb4\x77\x8d"
+DATA ·templatesData+5072(SB)/16,$"\x1e\xf8\xc0\xe4\xab\x8c\x53\xe6\x89\x13\x0a\xec\x12\xe9\x5b\xd2"
+DATA ·templatesData+5088(SB)/16,$"\xd2\x96\x81\xe1\xf5\xbd\x37\xd6\xa3\x85\x43\x57\x4b\xd3\x1e\xa9"
+DATA ·templatesData+5104(SB)/16,$"\xaf\x1e\x23\xab\x3f\xa1\x15\x1b\xd1\x25\x17\x88\x13\x51\x9d\x1f"
+DATA ·templatesData+5120(SB)/16,$"\x6d\x51\xf6\xbd\x6a\x37\xb7\xe9\x1a\x49\x20\x08\xf3\xa7\xed\xf0"
+DATA ·templatesData+5136(SB)/16,$"\xa9\x14\x92\x56\xf8\x61\x9f\xa8\x1d\xc8\x61\xf3\x55\x8d\x9b\x23"
+DATA ·templatesData+5152(SB)/16,$"\x4a\xd2\xae\xd0\x02\x23\x5a\xec\x0d\x8d\xad\x15\xbf\x0f\x6a\x2c"
+DATA ·templatesData+5168(SB)/16,$"\xe9\x36\x74\x43\x67\x71\xeb\xfa\xcc\x88\x63\xa2\xac\x7c\x44\x1c"
+DATA ·templatesData+5184(SB)/16,$"\x77\x90\xf8\x23\xc2\x29\x36\xec\x3d\x4e\x6c\xa4\x2d\x7f\x52\xcb"
+DATA ·templatesData+5200(SB)/16,$"\x43\x0b\xbe\xff\x82\x2a\x72\x0c\x6a\xc2\x64\x38\x99\x0c\xb2\x7c"
+DATA ·templatesData+5216(SB)/16,$"\xdf\x9c\x7f\x2f\x26\x55\xe3\x79\xa2\xfc\x51\xb0\xe7\xf5\x45\xec"
+DATA ·templatesData+5232(SB)/16,$"\x8a\x73\x07\x8e\xff\xd3\xfa\x8e\xf8\xe4\x91\xf5\xf3\x6c\x53\x27"
+DATA ·templatesData+5248(SB)/16,$"\x8f\xef\x6c\x4b\xbb\xec\xec\x67\x74\x9b\x1e\x90\xee\xca\xe1\xf8"
+DATA ·templatesData+5264(SB)/16,$"\xbc\x89\x9c\x73\x5e\x2a\x85\xd9\x6c\x0b\x0e\x77\x71\x9d\x32\xa4"
+DATA ·templatesData+5280(SB)/16,$"\xa7\xa3\xdc\xd4\x73\x3a\x9a\x8d\xe9\x28\xe5\x69\xf0\xb4\xcf\xe3"
+DATA ·templatesData+5296(SB)/16,$"\xa8\xca\x9f\x8f\x92\x98\x98\x4e\xc7\xc1\xfe\xa0\x7c\x73\x1b\x52"
+DATA ·templatesData+5312(SB)/16,$"\xbf\x01\xea\x87\x3f\x2e\xdf\xcf\x61\x30\xb4\xc4\x94\x7b\xb8\x3e"
+DATA ·templatesData+5328(SB)/16,$"\xac\x7a\x3c\x9c\x97\xf8\x46\x14\x4a\x22\xf0\xef\xdb\x13\x5e\x39"
+DATA ·templatesData+5344(SB)/16,$"\xf8\xec\x24\x75\x48\x27\x2e\x4e\x4c\x9f\x7e\x74\x92\xc2\x84\x94"
+DATA ·templatesData+5360(SB)/16,$"\xef\x04\xe0\x89\x36\xd6\x14\xb2\xaf\x7e\xf8\xb8\xc4\x53\x82\xcf"
+DATA ·templatesData+5376(SB)/16,$"\x14\x87\x2e\x29\xa8\x83\x6a\xa0\x93\xf8\x2d\x95\x30\xc7\x32\x1a"
+DATA ·templatesData+5392(SB)/16,$"\x8e\x6f\xa0\xe6\x0f\x19\x79\x8d\x49\xcc\x0a\x9f\xc0\x14\xe9\x75"
+DATA ·templatesData+5408(SB)/16,$"\xc4\x0d\xf7\x8a\x62\xf7\x92\x75\x26\xf7\x67\xb2\xb0\x15\x9c\x9d"
+DATA ·templatesData+5424(SB)/16,$"\x0d\xc2\x13\xdc\xf8\x0b\x2d\xff\x4d\x76\x3c\xa3\xfc\x8d\x9f\xf3"
+DATA ·templatesData+5440(SB)/16,$"\x2c\x3b\x74\x58\xa5\x9f\xc3\xef\x68\x62\xf8\x13\x5b\x1b\xdf\x50"
+DATA ·templatesData+5456(SB)/16,$"\xc3\x5b\x41\xe9\x40\xf2\xcc\xd9\x2c\xe5\xc0\xe1\xee\xea\xcc\x63"
+DATA ·templatesData+5472(SB)/16,$"\x9e\x03\x23\x2a\x23\xca\xe4\xc6\x8e\x09\xf6\x33\xab\xc0\xd7\x34"
+DATA ·templatesData+5488(SB)/16,$"\xfa\xd0\x67\x3c\xa7\xe5\x78\x87\xe4\x7c\x93\xe9\x48\x72\xe1\x73"
+DATA ·templatesData+5504(SB)/16,$"\xa0\x62\x7d\x68\x06\x6e\x34\x90\x95\x8f\x1b\x13\x79\x7d\xad\x48"
+DATA ·templatesData+5520(SB)/16,$"\xb2\xf5\xa1\x41\xa0\x15\xf0\x77\xf9\xf4\x99\x0c\x5e\x38\xf6\x92"
+DATA ·templatesData+5536(SB)/16,$"\x99\x8a\x26\xc9\xde\x11\x1e\x4e\x1c\xb3\xd2\x25\xc8\xdf\x68\x1d"
+DATA ·templatesData+5552(SB)/16,$"\x3f\x3a\x0a\x6a\x5e\x90\x6f\xee\xf3\x21\xd3\x48\x68\x83\x2f\xa2"
+DATA ·templatesData+5568(SB)/16,$"\x52\x73\xa7\xd8\x19\x1a\xb3\xb9\x15\x32\x69\x6e\xf5\x7d\xac\x1b"
+DATA ·templatesData+5584(SB)/16,$"\xe4\xa8\x86\xb4\xb3\x7a\xaf\xad\xa2\x4c\x26\xe9\xc8\xb6\x52\x86"
+DATA ·templatesData+5600(SB)/16,$"\x1e\xe9\x38\xe6\xd3\xcf\xc1\x58\xd8\x87\x06\xad\xd3\xb5\x0c\x9a"
+DATA ·templatesData+5616(SB)/16,$"\xd2\x06\x1e\x90\x20\x59\x0b\x79\x86\x13\xf8\x37\x00\x3c\x60\x25"
+DATA ·templatesData+5632(SB)/16,$"\x85\x77\xd4\x7d\x82\x07\xa7\x67\xed\x85\x36\xca\x6d\x77\x60\xa5"
+DATA ·templatesData+5648(SB)/16,$"\x0b\xfd\x42\xf4\x1d\x09\x5d\xe7\xe0\x61\x84\x27\xc2\x96\x40\x6d"
+DATA ·templatesData+5664(SB)/16,$"\x9e\x4d\x00\xd9\x53\x11\x27\x05\xec\xb8\xa5\x63\xe5\xc7\x77\xd0"
+DATA ·templatesData+5680(SB)/16,$"\x0b\xff\x55\xa4\x3e\xd5\xf5\x39\xec\x94\xfe\x7c\x3a\x6f\xe0\x01"
+DATA ·templatesData+5696(SB)/16,$"\x8b\xfc\x44\x42\xef\xd3\x5e\x6e\x17\x9d\xa4\xbd\x3c\x9c\x6e\x78"
+DATA ·templatesData+5712(SB)/16,$"\xa6\x42\xc4\xbe\xe6\xd0\x87\xbd\x64\x09\x6f\x97\x27\xca\x9d\xa6"
+DATA ·templatesData+5728(SB)/16,$"\xf2\x1a\x1e\xd7\x36\x7d\x21\x67\xd8\x35\x1b\x26\xfb\x5b\xa3\x8c"
+DATA ·templatesData+5744(SB)/16,$"\x31\x0c\xf4\x97\x1e\xd9\x18\xdf\x70\x42\x7a\xe1\x3f\x02\x0d\x07"
+DATA ·templatesData+5760(SB)/16,$"\xe2\x5b\x5a\x2a\x51\x7d\x6c\x73\x99\x9c\xf0\x12\x1e\xf1\x93\x07"
+DATA ·templatesData+5776(SB)/16,$"\x64\x91\xfa\x49\xf4\x28\xfb\xfc\x06\xa6\x09\xdf\xb4\x2d\x77\xb2"
+DATA ·templatesData+5792(SB)/16,$"\x3e\xd2\x5a\x2b\x93\x76\x64\x87\xbc\xee\x13\xd4\x5c\x89\xfe\x88"
+DATA ·templatesData+5808(SB)/16,$"\x9c\x7c\x0d\x40\x64\xfa\xf1\xd0\x56\x60\x2a\x3f\xc0\x4b\xcc\x61"
+DATA ·templatesData+5824(SB)/16,$"\xc6\x4b\x57\x7e\xe5\x99\x3f\x34\x27\x95\x36\x9f\x23\xfa\xd0\x33"
+DATA ·templatesData+5840(SB)/16,$"\x20\x03\x2d\xcc\xe3\xe3\xe2\xc7\xe4\x52\xcf\xbf\x65\x55\x52\xfd"
+DATA ·templatesData+5856(SB)/16,$"\x81\xf7\xf7\x13\xa1\xe9\xa4\x30\x9e\x9f\x16\x46\x63\xb1\x92\xfb"
+DATA ·templatesData+5872(SB)/16,$"\x02\x0b\xb0\x7f\x94\x30\x9e\xdf\x43\x18\x03\x32\xbe\x5e\x18\x49"
+DATA ·templatesData+5888(SB)/16,$"\x93\xdf\x67\x85\x32\xa0\x18\x85\x32\x07\xbd\x4f\x8a\xdf\x13\xce"
+DATA ·templatesData+5904(SB)/16,$"\xbf\xd9\x74\x71\x7e\x52\xd6\x6d\xb9\xa1\x29\x14\x81\x4c\x5f\x25"
+DATA ·templatesData+5920(SB)/16,$"\x0d\x6d\x46\xa6\x8a\xd1\xfd\xe9\x0a\xa8\xd1\x9e\xa9\x8d\x9d\x4e"
+DATA ·templatesData+5936(SB)/16,$"\x59\xec\x71\x79\xf1\xeb\x4b\x1c\x48\xd6\xcb\xb8\x73\x00\xe7\xff"
+DATA ·templatesData+5952(SB)/16,$"\x84\xad\xcd\x1f\x3f\xfa\xd5\x68\x8c\xd2\xd2\x1f\x92\x15\x18\x24"
+DATA ·templatesData+5968(SB)/16,$"\x63\x00\x22\xac\x68\xe1\x87\x84\x06\xa2\x8a\x7b\x8e\xb2\x20\x9d"
+DATA ·templatesData+5984(SB)/16,$"\x15\xf4\x64\xbf\x4b\x90\x2d\x13\xb8\x87\xe9\xaa\xef\x09\x3c\x99"
+DATA ·templatesData+6000(SB)/16,$"\xf9\x70\x35\xa0\x2a\x76\xc7\x24\x7c\x30\x8f\xc9\x27\x21\x7a\x0f"
+DATA ·templatesData+6016(SB)/16,$"\x0f\x61\xb6\xa4\x03\x0f\xc9\x19\x74\x47\xe1\x7c\x76\x2f\xcd\x9f"
+DATA ·templatesData+6032(SB)/16,$"\x4b\x79\x59\xe8\xa6\xb1\xd2\x85\x9b\x7d\xdc\x4a\xf8\x0a\xb2\x84"
+DATA ·templatesData+6048(SB)/16,$"\xa2\x0b\xa3\xdf\x57\xdf\xaa\x01\xbf\x28\x75\x9a\x9f\x9d\x85\x55"
+DATA ·templatesData+6064(SB)/16,$"\x57\xa4\x40\xa4\xea\xdc\x09\xe3\xe0\x66\x2c\xa3\x15\x3c\x9a\xe8"
+DATA ·templatesData+6080(SB)/16,$"\x76\x22\x94\xe0\x72\x88\x67\x09\x8a\x09\x22\x5e\xf9\x7f\x09\xf1"
+DATA ·templatesData+6096(SB)/16,$"\x9d\xa1\xb3\x93\x22\xf6\x2d\xff\x9e\x20\x1a\xa2\x56\xfe\x94\x2e"
+DATA ·templatesData+6112(SB)/16,$"\x6a\xdc\xcf\x88\x1a\x78\xe8\x59\x19\x4f\xfc\xf9\x60\x8c\xec\x92"
+DATA ·templatesData+6128(SB)/16,$"\xa9\xac\x5b\x53\x61\x4a\x52\xde\x09\xf6\xa2\xab\x7b\x10\x53\xf9"
+DATA ·templatesData+6144(SB)/16,$"\x5c\x25\x9d\x9d\x7e\x3c\x10\x76\xda\x47\xf3\xcf\xf3\xcf\x1c\xcd"
+DATA ·templatesData+6160(SB)/16,$"\x86\xcd\xff\x1d\xfc\x34\x6e\xf5\xff\x14\xae\x4e\x5e\x08\xa7\xae"
+DATA ·templatesData+6176(SB)/16,$"\x24\x04\x85\xa4\xe8\x98\x35\xdc\x14\xef\x1b\x89\x8b\x75\x7f\xe1"
+DATA ·templatesData+6192(SB)/16,$"\xd9\x71\xe0\xf9\xbe\x86\x36\x35\x0d\xf4\x0d\x5c\x9a\xe2\xf1\xc8"
+DATA ·templatesData+6208(SB)/16,$"\x6f\x86\x96\x91\x1a\x05\xe1\x0d\x39\x62\x9a\x49\xf0\x1b\xe2\xfb"
+DATA ·templatesData+6224(SB)/16,$"\x69\xa2\x2f\x7e\x97\x48\xb4\x0f\x4b\xd9\x6d\x54\x2f\x27\xd7\xf3"
+DATA ·templatesData+6240(SB)/16,$"\x1e\xf5\x3b\x46\x45\xe7\xd7\x5e\xa0\x31\x2e\x70\xdc\xe9\x6f\x58"
+DATA ·templatesData+6256(SB)/16,$"\xcd\xe4\x24\xe1\xbf\x6d\x3d\xec\xc1\x69\xe8\x73\x18\x7f\x95\x91"
+DATA ·templatesData+6272(SB)/16,$"\x9d\xca\x8b\x06\x6d\x7e\xe3\xf4\x66\x7c\xfa\x30\xf1\x10\x30\xc9"
+DATA ·templatesData+6288(SB)/16,$"\x92\xc2\x06\x38\x3a\xbf\xa4\x08\x43\xd8\x0c\x94\x4c\xba\x0c\xc7"
+DATA ·templatesData+6304(SB)/16,$"\x91\x3e\x88\xd6\xbf\x45\xee\xde\xc8\x6b\xd5\xd5\xfc\xff\xe6\x5c"
+DATA ·templatesData+6320(SB)/16,$"\xa8\xae\xe3\xaf\x14\x26\xb4\x93\xbd\x16\xa9\xcf\x52\xf1\x93\x27"
+DATA ·templatesData+6336(SB)/16,$"\x9e\x4c\xff\xde\x48\x2b\xdd\x09\x72\x4f\x85\x9f\xc8\xc4\x88\x8b"
+DATA ·templatesData+6352(SB)/16,$"\xb3\xb3\x94\xfc\x9f\xc6\xe4\x73\x77\xcc\xa0\x17\xf3\x75\xe1\xcf"
+DATA ·templatesData+6368(SB)/16,$"\x6e\xcf\x95\xdd\x08\x53\xcf\x61\x2c\x55\xc6\x91\x6c\x4f\xd3\x04"
+DATA ·templatesData+6384(SB)/16,$"\x62\x4a\x25\x03\xf9\x57\xb7\x9f\x22\x39\xc0\x77\x7d\x02\x33\x12"
+DATA ·templatesData+6400(SB)/16,$"\x0b\xba\xe9\x09\x51\x8c\xac\x32\xf5\xff\x7e\x56\x9e\x10\x70\x7b"
+DATA ·templatesData+6416(SB)/16,$"\x6a\x53\xfe\x0e\xbe\x83\x16\x79\x3f\xc7\x99\x86\xa5\xff\x1b\x00"
+DATA ·templatesData+6432(SB)/16,$"\x2c\x7d\x2f\xdc\x24\x4f\x00\x00\x1f\x8b\x08\x00\x00\x00\x00\x00"
+DATA ·templatesData+6448(SB)/16,$"\x02\xff\xdc\x5c\x7d\x73\xdb\x36\x93\xff\x9b\xfc\x14\x08\x67\x9c"
+DATA ·templatesData+6464(SB)/16,$"\x21\x7b\xb2\x64\xf7\xd2\x34\xb1\xa3\xce\x24\x71\x9c\x4b\x9b\xa4"
+DATA ·templatesData+6480(SB)/16,$"\xa9\xed\xdc\x5d\x27\x93\xc9\x50\x22\x28\x23\xe6\x8b\x4a\x40\x7e"
+DATA ·templatesData+6496(SB)/16,$"\x49\xaa\xef\xfe\xcc\xe2\x1d\x20\x29\x4b\x76\xdc\xf6\x79\xf2\x47"
+DATA ·templatesData+6512(SB)/16,$"\x44\x42\xc0\xee\x62\xb1\xd8\xfd\xed\x02\xf2\x3c\x9d\x9e\xa5\x33"
+DATA ·templatesData+6528(SB)/16,$"\x8c\x70\x39\xc1\x59\x86\xb3\x30\x24\xe5\xbc\x6e\x18\x8a\xc3\x20"
+DATA ·templatesData+6544(SB)/16,$"\x9a\x5c\x31\x4c\xa3\x30\x88\xa6\x75\x39\x6f\x30\xa5\xa3\xd9\x17"
+DATA ·templatesData+6560(SB)/16,$"\x32\xe7\x0d\xcd\xd5\x9c\xd5\x23\x7a\x9a\xee\xc2\x2b\xae\xa6\x75"
+DATA ·templatesData+6576(SB)/16,$"\x46\xaa\xd9\x68\x92\x52\xfc\xf0\x01\x34\x91\x5a\xfc\x3f\xca\xa9"
+DATA ·templatesData+6592(SB)/16,$"\x7c\x20\xf5\x82\x91\x02\x5e\x2a\xcc\x46\xa7\x8c\x71\x4a\x35\xff"
+DATA ·templatesData+6608(SB)/16,$"\x7a\x9e\xb2\x53\xf5\x39\xca\x49\x81\x55\x43\x83\xf3\x02\x4f\x19"
+DATA ·templatesData+6624(SB)/16,$"\x3c\x32\x4c\x19\xa9\x66\xfc\x91\x94\x18\x3e\x17\x15\x4d\x73\x1c"
+DATA ·templatesData+6640(SB)/16,$"\x85\x49\x18\x4e\xeb\x8a\x72\xa9\x49\x95\xe1\x4b\x04\xff\xc6\x28"
+DATA ·templatesData+6656(SB)/16,$"\x7a\x72\xca\xca\xe2\xa7\x27\xa7\x38\xcd\x70\xf3\xd3\x93\x91\x7a"
+DATA ·templatesData+6672(SB)/16,$"\x98\xd4\xd9\xd5\x4f\x4f\x46\xf0\xf1\x64\xc4\xfb\x44\x61\x50\x92"
+DATA ·templatesData+6688(SB)/16,$"\x12\x9f\x5c\xcd\x31\x1f\xc9\xf0\x25\xe3\xdf\xec\xa3\xe9\x69\xda"
+DATA ·templatesData+6704(SB)/16,$"\x50\xcc\xc6\x0b\x96\x6f\x3f\x8a\xc2\x80\x62\x76\x42\x4a\xcc\x39"
+DATA ·templatesData+6720(SB)/16,$"\xec\xfe\xf0\xe3\xe3\xef\x1f\x7d\xff\xe0\xf1\x0f\x92\xf3\x31\xf9"
+DATA ·templatesData+6736(SB)/16,$"\x82\xd1\x18\x91\x8a\x3d\x7c\x10\x17\xb8\x8a\x79\x6b\x92\x80\x8c"
+DATA ·templatesData+6752(SB)/16,$"\xe7\x69\xa3\x25\x7c\x06\xba\x45\x52\xce\x0f\x1f\x41\xd5\xb2\xab"
+DATA ·templatesData+6768(SB)/16,$"\xec\xf0\x5c\xea\x1c\x67\x68\x8c\xd4\x02\xc4\x66\xac\xea\x77\x92"
+DATA ·templatesData+6784(SB)/16,$"\xce\x10\x52\x84\x58\x3a\x73\xba\x24\x61\x98\x2f\xaa\x29\x3a\xc1"
+DATA ·templatesData+6800(SB)/16,$"\x94\xfd\x5f\x43\x18\x3e\x24\x05\x8e\x19\xfa\x4e\x2a\x73\x78\x92"
+DATA ·templatesData+6816(SB)/16,$"\xa0\xaf\x61\x90\x91\x66\x80\x72\xb4\x37\x46\x65\x7a\x86\x0f\x69"
+DATA ·templatesData+6832(SB)/16,$"\x9c\x84\x41\x86\x73\xdc\xa0\x9a\x0e\x8f\x70\x59\x9f\xe3\xa7\x45"
+DATA ·templatesData+6848(SB)/16,$"\x11\x67\xa4\x49\xc2\x30\xc8\xeb\x06\x7d\x1a\x20\x20\x01\x43\x9a"
+DATA ·templatesData+6864(SB)/16,$"\xb4\x9a\x61\xf4\xe1\x23\x65\xcd\x62\xca\x80\x5c\x50\xa5\x5c\x3d"
+DATA ·templatesData+6880(SB)/16,$"\x08\x51\xd6\x90\x6a\x16\x06\x01\xac\xa9\xdb\x72\x9a\xd2\x17\x4d"
+DATA ·templatesData+6896(SB)/16,$"\x53\x37\x68\x52\xd7\x45\x18\x04\x59\xca\x52\xde\x43\x28\x23\x0c"
+DATA ·templatesData+6912(SB)/16,$"\x96\x40\xe9\x6b\x74\x84\xe7\x45\x3a\xc5\xd1\x00\x45\x23\x8a\x19"
+DATA ·templatesData+6928(SB)/16,$"\x48\x4d\x87\xb0\x30\xd1\x00\xe5\x69\x41\xf1\x40\xa9\x2f\xa2\x75"
+DATA ·templatesData+6944(SB)/16,$"\x89\x11\xd0\x89\x92\xe5\x80\x0f\x7e\x9a\x65\x7c\x20\x29\xd3\x19"
+DATA ·templatesData+6960(SB)/16,$"\xa6\xa3\x39\x99\xb2\x45\x83\x87\xf4\x7c\xd6\x33\x9a\x77\x74\x69"
+DATA ·templatesData+6976(SB)/16,$"\x3c\x4b\x33\xf4\x0e\xcc\xb1\x25\xc1\xe8\x33\x1d\x81\x4d\xd3\xe1"
+DATA ·templatesData+6992(SB)/16,$"\x67\x1a\x0d\x10\x6b\x16\x3e\x39\x3a\x6d\xc8\x9c\x59\xf4\x96\x5c"
+DATA ·templatesData+7008(SB)/16,$"\x3f\x6c\x78\xb4\xa8\x62\x50\xe0\x10\x54\x35\x40\xb0\x48\xad\x65"
+DATA ·templatesData+7024(SB)/16,$"\x09\x83\x20\xc0\x4d\x03\x3a\xce\x87\xd6\xea\xc1\x30\xd0\xa7\x58"
+DATA ·templatesData+7040(SB)/16,$"\x82\x21\x10\x1f\xc0\x42\xbd\xa9\x33\xfc\x0e\x37\x65\xc2\x47\x92"
+DATA ·templatesData+7056(SB)/16,$"\x1c\xc1\xe0\xf1\x18\x55\xa4\xe0\x5c\x79\x1b\x1f\xa2\x75\x2f\x9a"
+DATA ·templatesData+7072(SB)/16,$"\x03\x36\xe4\xaf\x79\x1c\x81\xa1\xa0\x2d\x8a\x32\x92\xa1\xaa\x66"
+DATA ·templatesData+7088(SB)/16,$"\x40\xa2\x6e\x50\x4a\x11\xbe\x9c\xe3\x29\xc3\xa0\x4e\x2d\x77\xc2"
+DATA ·templatesData+7104(SB)/16,$"\x47\x2f\xe1\xff\x25\xc2\x05\xc5\x86\xcd\xbd\x35\xf9\x34\x98\x2d"
+DATA ·templatesData+7120(SB)/16,$"\x9a\x0a\x67\x68\x51\x29\x0e\x92\xe7\xd6\xb9\xcd\x6a\x00\xad\x36"
+DATA ·templatesData+7136(SB)/16,$"\xbf\x30\x5c\xc1\x88\xe4\x48\x28\x48\xab\xef\xd7\x39\xae\x8c\xe6"
+DATA ·templatesData+7152(SB)/16,$"\x92\x7d\xfe\xcd\x3d\x5b\x37\x1d\xc2\xd5\x73\x5c\x71\x42\x37\x12"
+DATA ·templatesData+7168(SB)/16,$"\xd3\xd6\x08\x48\x34\xd1\xe2\x08\x4f\x38\x3c\xc2\x69\x06\xdb\xaa"
+DATA ·templatesData+7184(SB)/16,$"\x57\xa2\x0e\x91\xe4\x98\x9b\x08\xe4\x49\xc4\x95\x27\xdd\xeb\xf0"
+DATA ·templatesData+7200(SB)/16,$"\x00\xe3\xf9\x8b\x3f\x16\x69\x11\x4f\x2c\xab\x4a\x74\x5f\x4b\x92"
+DATA ·templatesData+7216(SB)/16,$"\x03\x69\x19\x33\xcc\xb4\x51\xa0\x69\x5d\x31\x5c\x31\x8a\xe2\x2d"
+DATA ·templatesData+7232(SB)/16,$"\x9a\xc8\x66\xfe\x1c\x0d\x90\x43\x51\xd2\x5b\x86\xd6\x87\x58\x4b"
+DATA ·templatesData+7248(SB)/16,$"\x78\x5e\x26\x61\xb0\x0c\x97\xb6\xd3\x4a\x8b\xb3\xbf\xcc\x5f\xf9"
+DATA ·templatesData+7264(SB)/16,$"\xde\x4a\xbf\xc3\xd2\x20\x24\x14\x0c\xaf\x62\x7e\x1f\x3e\xaa\x0e"
+DATA ·templatesData+7280(SB)/16,$"\xcb\xaf\x2d\x4f\x31\x49\x61\xab\x54\xa4\x18\xe8\x7e\x5f\x79\xe3"
+DATA ·templatesData+7296(SB)/16,$"\x52\xba\x95\x97\x75\x2d\x7c\x53\xbb\xdb\x88\xb7\x73\x3f\xae\x3c"
+DATA ·templatesData+7312(SB)/16,$"\x5d\xdb\xf7\x45\x3c\x4e\x52\xf3\x34\xfa\xec\xbe\xd8\x04\x14\xd3"
+DATA ·templatesData+7328(SB)/16,$"\xe3\x33\x32\x57\x4c\x55\x98\x1d\x42\xe3\x01\x69\x5c\x09\x96\x9b"
+DATA ·templatesData+7344(SB)/16,$"\x7a\xab\x20\x08\x20\xbe\x15\x84\xda\x9a\x09\x82\x7c\x28\xd6\xd0"
+DATA ·templatesData+7360(SB)/16,$"\x78\x2d\x3e\x1c\x38\x4b\x05\x0f\x10\xa9\xf2\x1a\x81\x73\x7b\x55"
+DATA ·templatesData+7376(SB)/16,$"\xe5\xb5\xd8\x26\x5c\xd7\x89\xf8\x90\x66\xc8\x49\x8f\x51\x3a\x9f"
+DATA ·templatesData+7392(SB)/16,$"\xe3\x2a\x8b\xe1\x6d\x80\x80\x8c\x30\x2a\xb1\x23\x84\xa9\xe1\xa6"
+DATA ·templatesData+7408(SB)/16,$"\xe1\x26\xa5\x3d\x61\x87\xa1\x8b\xf1\xa2\x3b\x5f\x4f\x65\xed\x6d"
+DATA ·templatesData+7424(SB)/16,$"\x53\x87\x09\x08\x3f\x60\x39\x42\x34\xab\x59\xbc\x75\x6e\x59\xfb"
+DATA ·templatesData+7440(SB)/16,$"\x39\x58\x7b\x9b\xac\x6b\xdc\x8e\x75\x3f\xcd\xb2\x17\x12\x3a\xdd"
+DATA ·templatesData+7456(SB)/16,$"\xda\xc8\x87\xf1\x6b\x52\x9d\x3d\xcd\x32\xdc\x24\xc3\xa7\x59\x06"
+DATA ·templatesData+7472(SB)/16,$"\x6f\x71\x34\x2a\x48\x75\xa6\x4d\xc6\x79\x71\x0d\x4c\x82\x1a\x20"
+DATA ·templatesData+7488(SB)/16,$"\x45\xcb\xb4\x28\x80\xaf\x0a\x65\x93\x26\xfa\x76\x71\x5f\x61\x45"
+DATA ·templatesData+7504(SB)/16,$"\xd3\xd2\x8a\xfc\x6a\x83\x21\xdb\x90\x3c\xbc\x20\xb7\xdc\x31\xc8"
+DATA ·templatesData+7520(SB)/16,$"\x8a\x29\xeb\xd8\x31\x93\x06\xa6\x05\xdf\x3b\x5b\x10\x5a\x23\x8e"
+DATA ·templatesData+7536(SB)/16,$"\x63\x97\x12\x02\xc8\xbd\xf1\x3a\x6d\x66\xdd\x94\xbe\x50\x06\x5b"
+DATA ·templatesData+7552(SB)/16,$"\x55\x1a\x9e\x87\xce\x06\x68\x27\xe9\x61\xa0\x86\x7a\x8c\xde\x57"
+DATA ·templatesData+7568(SB)/16,$"\x53\x3d\xba\x7b\x6b\xf7\x8a\xee\x51\x3a\x58\xcc\x0b\x32\x4d\x19"
+DATA ·templatesData+7584(SB)/16,$"\xee\x10\x5a\x4a\x20\xa9\x70\x0f\x03\x00\x45\x8e\x7c\x43\x28\x05"
+DATA ·templatesData+7600(SB)/16,$"\x30\x0d\xe3\x4a\xf1\xdc\xc9\xdd\x1f\x77\x58\x17\x19\x6e\x94\x0b"
+DATA ·templatesData+7616(SB)/16,$"\x59\xd9\x15\x0c\x90\x77\x74\xac\xae\x77\xc4\x86\xbe\x46\x47\xf6"
+DATA ·templatesData+7632(SB)/16,$"\x58\xed\x20\x63\xfa\x66\x4f\x79\x50\x49\xd9\x9e\x13\x91\x6e\x0e"
+DATA ·templatesData+7648(SB)/16,$"\x96\x84\x7f\x10\x91\xd0\xc7\x49\xe8\xe2\x14\x57\x28\xcd\x80\x1d"
+DATA ·templatesData+7664(SB)/16,$"\xda\xa2\x2a\x26\xf3\x58\xdf\x82\x4d\x7d\x38\xc6\xb0\x7a\x59\xb3"
+DATA ·templatesData+7680(SB)/16,$"\x76\xa8\xd7\xd4\xad\xa0\xaf\x71\x4f\x72\xe7\x30\x09\x3a\x5f\x07"
+DATA ·templatesData+7696(SB)/16,$"\x44\xfa\xe0\x10\xf7\xf3\x9f\x38\x53\x52\xe0\xe1\x31\x4b\x59\x9c"
+DATA ·templatesData+7712(SB)/16,$"\x68\xa8\xc4\x3d\x3d\x00\xa5\x2a\xaf\xcd\x02\x43\x70\x48\x86\xea"
+DATA ·templatesData+7728(SB)/16,$"\x8d\xc6\xc9\xfe\x46\x7e\xdd\x16\x5d\x13\xd1\x40\x57\xcc\x03\x9d"
+DATA ·templatesData+7744(SB)/16,$"\xa7\x05\xc9\xe0\xff\x05\x06\x07\x8f\x2c\x0f\x8f\xb3\xd5\x3e\xde"
+DATA ·templatesData+7760(SB)/16,$"\xc0\x19\xd7\xe1\x07\x36\x78\xf7\x76\xa9\xf2\xb0\x8d\x48\x72\xb2"
+DATA ·templatesData+7776(SB)/16,$"\x28\x69\x21\xf9\x9e\x45\x72\x08\x25\xfb\xbe\xf9\xf6\x2a\x78\x13"
+DATA ·templatesData+7792(SB)/16,$"\xed\xf2\x8e\xf6\xea\x1b\x05\xea\x19\x69\x05\x4e\x0b\x9c\x36\x08"
+DATA ·templatesData+7808(SB)/16,$"\x6b\xc5\x2a\xed\x49\x7d\x01\xef\xa5\x8f\xee\x9e\x66\x59\x47\xd6"
+DATA ·templatesData+7824(SB)/16,$"\x73\x67\xd9\x68\x57\x5c\xb2\xda\x08\x3d\x54\xad\x32\x27\xd5\xfb"
+DATA ·templatesData+7840(SB)/16,$"\x05\xb5\xb2\x54\x2b\x5a\x19\x6f\xae\x7a\x51\x28\x02\x88\x7f\xbc"
+DATA ·templatesData+7856(SB)/16,$"\x10\xa0\xc2\x55\x18\x08\x69\xf6\xf8\x57\xc6\x7d\xa3\x5c\xba\xd5"
+DATA ·templatesData+7872(SB)/16,$"\x30\x10\xa2\xc9\x0e\x16\xb0\x0b\x03\x23\xce\x9e\x48\x35\x41\xa3"
+DATA ·templatesData+7888(SB)/16,$"\x83\x36\x59\x97\x30\x29\xb0\x4f\x16\x79\xb1\x22\x0c\xd4\xdc\xc5"
+DATA ·templatesData+7904(SB)/16,$"\xf7\x8a\xb8\xc5\xcf\x6a\x04\x0d\xec\xe9\xc9\xb9\xa1\x30\x0c\x6c"
+DATA ·templatesData+7920(SB)/16,$"\x6d\x18\x31\xb9\x42\xf6\x6c\x8d\xd8\xa5\x91\x15\x13\x01\x44\xcd"
+DATA ·templatesData+7936(SB)/16,$"\x4b\x41\x2b\xa7\xc0\xd5\xf4\xcf\x9f\xcc\xf5\xab\x02\x46\x2c\xd7"
+DATA ·templatesData+7952(SB)/16,$"\x7c\xad\x05\xba\x3b\xf1\x6f\x80\xfc\x35\x6a\x57\x31\x87\x8f\x93"
+DATA ·templatesData+7968(SB)/16,$"\x9b\x8a\xf7\x0a\x28\xe3\x2e\xec\xbb\xf8\x3b\xb1\xe9\x92\x58\x54"
+DATA ·templatesData+7984(SB)/16,$"\xf0\x86\xef\x6a\x52\x31\xdc\xc4\xf7\x4d\x5c\x16\x3e\x95\xbb\x35"
+DATA ·templatesData+8000(SB)/16,$"\x94\x43\x54\xf7\x8b\x1f\x3c\x77\x79\x96\x52\xab\x31\x19\xa0\x48"
+DATA ·templatesData+8016(SB)/16,$"\x05\x42\x98\xe6\x00\x31\x52\xe2\xe1\xdb\xfa\x22\x4e\x86\xef\x2b"
+DATA ·templatesData+8032(SB)/16,$"\x72\x19\xcb\x1e\x91\x50\x6a\x10\xf0\xae\x46\x4d\x4e\x49\x85\x32"
+DATA ·templatesData+8048(SB)/16,$"\x19\x44\xed\xd0\x65\x0b\xc4\x37\xed\x9a\x22\xf9\x72\xb8\xe1\xf9"
+DATA ·templatesData+8064(SB)/16,$"\xef\x04\x1f\x6b\xd7\x6c\x3c\x0c\x62\xf3\x70\xf9\xf5\xc2\x11\x3f"
+DATA ·templatesData+8080(SB)/16,$"\x36\x7a\xb1\x40\x28\x14\xd2\x90\xb6\x8d\xf1\x78\xf0\x16\x5f\xc4"
+DATA ·templatesData+8096(SB)/16,$"\xbb\x09\x44\x54\xa3\x7e\x99\x2d\x8b\x45\xb5\xd3\x18\xa9\xd6\x3d"
+DATA ·templatesData+8112(SB)/16,$"\xdb\x7c\xa2\x91\xa8\xc8\xd1\x51\x41\x26\x76\xed\x2e\xb2\x9f\xa3"
+DATA ·templatesData+8128(SB)/16,$"\x01\xda\xd1\xa4\xb6\x77\x77\xb4\xcd\xa8\x92\x21\x07\xae\x51\xd4"
+DATA ·templatesData+8144(SB)/16,$"\xc6\x47\x6c\x78\x98\xb2\xb4\xb8\x06\xb0\xf1\xe0\x63\xc1\xa3\xa5"
+DATA ·templatesData+8160(SB)/16,$"\x09\x63\x3c\x56\xb9\x61\x4c\x24\xe4\x52\x6e\x91\x2f\x98\x39\x44"
+DATA ·templatesData+8176(SB)/16,$"\xf6\x46\x5d\xbd\x47\x49\x8e\x88\x4e\xaf\x41\x2b\x54\xa0\x82\x57"
+DATA ·templatesData+8192(SB)/16,$"\xbf\x1e\x1e\xc7\x79\x22\x78\x7f\xd8\xdd\xfb\xd8\x05\xfb\x8c\x15"
+DATA ·templatesData+8208(SB)/16,$"\xc0\x98\x75\x51\x9f\x81\xb7\xa5\x06\x1c\x6f\xea\x8c\xaf\xb0\xda"
+DATA ·templatesData+8224(SB)/16,$"\x08\xfb\xa8\x04\x56\x46\xdb\x2d\x8e\x72\xc4\x66\x50\x8d\xcb\x51"
+DATA ·templatesData+8240(SB)/16,$"\xda\xab\x98\xf4\xdb\xde\x31\x66\xaf\x2a\x86\x67\x0d\x61\x57\xb7"
+DATA ·templatesData+8256(SB)/16,$"\x4d\xc4\xc5\x41\x04\x51\xe4\xd0\x18\x45\xf4\x34\xfd\xef\x47\x0f"
+DATA ·templatesData+8272(SB)/16,$"\xb6\xeb\x3f\xfe\x77\xf1\x34\xff\xff\xa3\x5f\xd2\xf9\x8f\x79\x36"
+DATA ·templatesData+8288(SB)/16,$"\x9b\x3e\xff\xfd\x87\xc5\xd5\xd9\x9b\x87\xff\x75\xf4\xf8\xe5\x1f"
+DATA ·templatesData+8304(SB)/16,$"\xbf\x3d\xfa\x65\xb4\xb8\xbc\x7a\xdc\x5c\xfe\xf8\x3f\x6f\x7f\x2b"
+DATA ·templatesData+8320(SB)/16,$"\x5e\xfe\x5e\xec\x9e\xbd\xfb\xf2\xdb\x69\xbd\x7b\x71\xf9\xe0\xe7"
+DATA ·templatesData+8336(SB)/16,$"\x8b\xdf\x1f\x5d\x3c\x8f\xee\xaa\xf6\xae\x72\x69\xdc\x91\xfc\xda"
+DATA ·templatesData+8352(SB)/16,$"\xe9\xe6\x8a\xa4\xb1\x37\x45\xbc\x75\x7a\xa7\x17\xe6\x18\x33\x06"
+DATA ·templatesData+8368(SB)/16,$"\x09\x9e\xbb\x58\xc6\xc9\x68\x95\xdf\x6d\x4a\x27\x93\xf5\xbb\xca"
+DATA ·templatesData+8384(SB)/16,$"\xe9\x0c\xf9\xff\xb4\xa4\x8e\x98\x9c\x43\xaf\x9f\x48\x3a\xcc\x72"
+DATA ·templatesData+8400(SB)/16,$"\x26\xfb\x88\x80\x68\x66\xfb\xb4\x73\x37\xdd\xfb\x7a\x87\x40\x9d"
+DATA ·templatesData+8416(SB)/16,$"\xdc\x8d\x57\xa3\x89\x63\x28\xff\x4e\x69\xdb\x26\xfa\x8b\xa2\x75"
+DATA ·templatesData+8432(SB)/16,$"\x13\x36\xa3\x69\xa5\x32\x50\x52\x5f\xb6\xc6\x8b\x8a\xfd\xb1\xf9"
+DATA ·templatesData+8448(SB)/16,$"\x51\x32\x8c\x81\x60\x93\xa7\x53\x61\x16\xc0\xf1\xf8\x8a\x32\x5c"
+DATA ·templatesData+8464(SB)/16,$"\x86\x41\xa0\x0b\x94\xa1\x28\xcd\xda\x71\xb9\x8d\x7a\x51\xe4\xbe"
+DATA ·templatesData+8480(SB)/16,$"\x81\x5c\xea\x84\x55\xfb\xf5\x01\x52\xa7\xb6\xf2\xdb\x93\x74\xa6"
+DATA ·templatesData+8496(SB)/16,$"\xc3\xb4\x39\x09\x95\xcf\x0a\x3a\xc8\xda\xe8\x69\x5d\x62\x4d\xde"
+DATA ·templatesData+8512(SB)/16,$"\x79\xe9\x12\x47\x43\x0b\x87\x06\x65\x69\xc3\xf4\x38\xf7\xcd\x61"
+DATA ·templatesData+8528(SB)/16,$"\xd0\x33\x9c\x88\xe2\x9d\xfa\xd4\x45\xfd\xee\xee\x45\x5d\xf3\xb2"
+DATA ·templatesData+8544(SB)/16,$"\xa2\xfa\x54\x0d\xdd\xbd\x4b\xe3\xaf\xad\x47\x39\x37\xcf\x81\x7b"
+DATA ·templatesData+8560(SB)/16,$"\x14\x34\xb8\x52\xf2\x44\xfa\x21\xb2\x94\xdf\xa1\xa8\x75\xe0\x99"
+DATA ·templatesData+8576(SB)/16,$"\x7b\x7a\xe1\x6a\x5e\x4f\xcd\x16\x59\xab\xc7\x52\x70\x07\xc6\x5b"
+DATA ·templatesData+8592(SB)/16,$"\x57\xa9\xad\x7d\x67\xf6\x89\xa4\xe2\xfb\x16\x07\xbf\xe1\x4b\xc2"
+DATA ·templatesData+8608(SB)/16,$"\xcd\x1f\xe1\x8a\x35\x57\x91\x8b\xe0\x6e\x19\x9a\x09\x3d\x20\x4d"
+DATA ·templatesData+8624(SB)/16,$"\x57\x09\xc2\x09\xd5\x87\x3c\x81\xf4\x74\x27\xad\xde\x8e\xd8\xcf"
+DATA ·templatesData+8640(SB)/16,$"\x4f\x53\x52\xf9\x8a\xeb\xec\x69\x45\x6d\xa9\x36\x71\x9c\x6d\xf7"
+DATA ·templatesData+8656(SB)/16,$"\x79\x55\x21\xaf\x5b\x07\x56\xf0\x8a\xea\x9e\xa1\xca\x2e\x16\x5c"
+DATA ·templatesData+8672(SB)/16,$"\x38\x48\xab\x59\xe1\xa1\x8a\xce\x8e\x12\x7d\xa0\x0e\x29\x3c\x5b"
+DATA ·templatesData+8688(SB)/16,$"\xf6\xc6\x6e\x08\x3d\x56\x47\xcf\xbb\xc2\x16\x70\xd6\xdc\x0b\x2b"
+DATA ·templatesData+8704(SB)/16,$"\x34\xa2\x90\x29\xf5\x01\x31\xe4\x01\xbe\x42\x94\x60\x0e\x56\x10"
+DATA ·templatesData+8720(SB)/16,$"\xff\x5b\x4b\xe8\x53\xda\xe0\x34\xfa\xcf\x3f\x7b\x0e\x8a\xad\x9b"
+DATA ·templatesData+8736(SB)/16,$"\x26\xed\xd9\x1e\x29\x0c\xc1\x4b\x66\x6a\x3b\x95\x29\x9b\x9e\x72"
+DATA ·templatesData+8752(SB)/16,$"\x15\x58\xd1\x39\x13\xcf\x06\x60\x4c\x5c\xdf\xed\xa5\x8f\xb7\xc2"
+DATA ·templatesData+8768(SB)/16,$"\x57\x46\xcf\x2b\xf0\x95\x03\x02\xe0\xf4\x52\x1c\x98\xde\xe8\xdc"
+DATA ·templatesData+8784(SB)/16,$"\x92\xe4\xbc\x2c\x00\xc6\x62\x6f\x57\x74\xff\xbe\xce\x87\x70\x9c"
+DATA ·templatesData+8800(SB)/16,$"\xdc\x97\xf0\xe1\xf8\xaa\x84\xe3\x12\xe8\xbd\xe3\x87\x70\xee\x97"
+DATA ·templatesData+8816(SB)/16,$"\xb6\x28\x2a\xeb\x0c\x23\x22\xe5\x07\xda\x03\x9b\x92\x0c\xdd\xea"
+DATA ·templatesData+8832(SB)/16,$"\x3c\xb4\x22\x85\x8c\xb6\x3c\x8d\xf9\x64\xad\xf8\x09\x2e\xe1\xdc"
+DATA ·templatesData+8848(SB)/16,$"\x37\x8e\xd4\xd1\xe0\x36\x28\x23\xba\xe6\x98\xf1\x79\x3d\xbf\x8a"
+DATA ·templatesData+8864(SB)/16,$"\x39\xad\x36\xe2\xe9\x34\x2a\x1e\xe0\xf5\x61\xf3\xcf\x35\xa9\xc4"
+DATA ·templatesData+8880(SB)/16,$"\x70\xed\x9e\xed\xe0\x71\x43\xcb\x33\x4a\x02\xf1\x0c\xc4\x69\x70"
+DATA ·templatesData+8896(SB)/16,$"\xca\x30\xe2\x1a\x35\x88\xd0\xb1\x33\x95\x81\xdb\x40\x07\x24\xa6"
+DATA ·templatesData+8912(SB)/16,$"\xff\xa0\xc2\x74\x55\x33\x55\x99\x96\x51\x41\x1f\x90\x5a\x65\x68"
+DATA ·templatesData+8928(SB)/16,$"\x1d\x39\x74\xaf\xa2\x9e\xa6\x85\xd3\xd2\x2a\x55\x77\xd4\xa5\xa3"
+DATA ·templatesData+8944(SB)/16,$"\xaa\xee\x2a\x50\x4a\xe4\x4a\xad\x9a\xa4\x94\xeb\xda\x72\x34\x1f"
+DATA ·templatesData+8960(SB)/16,$"\xb8\x4e\x15\x5a\x4c\x6b\xcf\xd4\x33\x85\x03\xe8\x2b\x65\xae\x62"
+DATA ·templatesData+8976(SB)/16,$"\x87\xaa\xba\xaf\x72\xfc\xcd\xb9\x46\xea\x00\xd7\x65\xd6\x3a\xd8"
+DATA ·templatesData+8992(SB)/16,$"\xb5\x99\xb9\x8c\x3a\x68\x8a\x59\xf0\x25\xf4\xc9\xf6\x4d\xc0\x13"
+DATA ·templatesData+9008(SB)/16,$"\x9e\x8f\xdd\x43\xfd\x82\x47\x1d\xc7\x0e\xd1\x48\xbc\x72\x6b\x72"
+DATA ·templatesData+9024(SB)/16,$"\x27\xbd\xe9\xdd\xb5\x7c\xf8\x9e\xe2\xd7\x20\x84\xe8\xce\xe5\x49"
+DATA ·templatesData+9040(SB)/16,$"\x42\xc5\xec\x16\x31\x56\x6d\x88\x9e\x10\xab\x2e\x26\x21\x26\xa2"
+DATA ·templatesData+9056(SB)/16,$"\xad\xa8\xb7\x75\xe6\xeb\xe1\xf5\x31\x54\x87\xdc\x01\xf2\xa7\x61"
+DATA ·templatesData+9072(SB)/16,$"\x92\xf0\x56\x4c\x86\x88\xd0\x4f\xaf\x55\x71\x96\x44\x2d\xa1\xd6"
+DATA ·templatesData+9088(SB)/16,$"\x8c\xd1\x8e\x7e\x82\x4e\x88\xe0\x04\xe5\x34\x43\x75\x25\xcf\x9b"
+DATA ·templatesData+9104(SB)/16,$"\xba\x41\x2e\xf8\xad\x2e\x65\x6d\x76\x7f\xcc\x3f\x7b\xbd\x05\x32"
+DATA ·templatesData+9120(SB)/16,$"\xb0\xee\x90\x39\xc7\xad\xde\x2d\x32\xff\xbe\xdd\xbd\xf5\x34\x61"
+DATA ·templatesData+9136(SB)/16,$"\xae\xf7\x39\xf3\xef\xaf\x61\x3b\xb7\xd6\x56\x58\xc0\x31\xc6\x67"
+DATA ·templatesData+9152(SB)/16,$"\xc7\x80\xba\xfb\xcd\xc0\x99\x8e\x1a\xf3\x7c\xd1\x34\xb8\xda\x74"
+DATA ·templatesData+9168(SB)/16,$"\xd4\x8b\x2a\xdb\x70\xc4\xb3\x74\xcd\x11\xd6\x2e\x01\xad\x01\x64"
+DATA ·templatesData+9184(SB)/16,$"\x58\xbd\x51\xe4\xe6\x80\xe6\xe1\xf3\xa2\xa6\x58\x56\x81\xa0\x07"
+DATA ·templatesData+9200(SB)/16,$"\x7f\xef\x62\x2c\x06\x75\x9f\x49\xf4\xee\xf7\x97\x35\x5b\x1f\xd7"
+DATA ·templatesData+9216(SB)/16,$"\x59\x4b\x06\x5f\xf4\x96\xc0\x80\xe8\xa4\x9e\x2d\xa8\xe8\xd6\x63"
+DATA ·templatesData+9232(SB)/16,$"\x0f\x7e\xd5\xaf\x55\x58\x56\x18\x01\xc5\x0a\x2e\x9a\x62\x08\xdf"
+DATA ·templatesData+9248(SB)/16,$"\x17\xac\x9c\xaf\xc6\x64\x39\x55\x88\xcc\xaa\xb1\x3c\xf4\x2b\x27"
+DATA ·templatesData+9264(SB)/16,$"\xfd\x35\x13\x09\x27\x0c\xee\x52\x1c\xed\x5e\xfc\xc8\x6f\xdd\xc2"
+DATA ·templatesData+9280(SB)/16,$"\x8a\x48\x28\x5a\x37\xa0\xc4\x04\xfd\x9b\x51\x89\x2f\x69\xeb\x9e"
+DATA ·templatesData+9296(SB)/16,$"\x53\x3b\x3e\xf6\xc8\xeb\x76\xdc\x48\xe4\xfe\x5a\x50\x57\x01\x2a"
+DATA ·templatesData+9312(SB)/16,$"\xa7\x37\xd2\xa7\xa9\x92\xd0\xe8\x6f\x50\xaf\x53\xac\x91\xf7\x40"
+DATA ·templatesData+9328(SB)/16,$"\xe5\xa5\x81\xeb\x04\xfe\x4c\x85\x88\x4a\xae\x30\x08\x7a\x74\x11"
+DATA ·templatesData+9344(SB)/16,$"\x06\x3d\x0c\xad\xea\xd0\x75\x0c\xfb\x58\x7d\xa6\xdd\xf4\x65\xed"
+DATA ·templatesData+9360(SB)/16,$"\x28\x0c\xd4\x66\xf1\x87\x7b\xcb\xd3\x05\xb9\xb4\x94\x8a\x01\xcf"
+DATA ·templatesData+9376(SB)/16,$"\x60\x94\x60\x4e\x12\xa3\x2f\x91\xca\x2f\x73\xbd\x99\x3d\x87\x6e"
+DATA ·templatesData+9392(SB)/16,$"\xa1\x1d\x79\x66\xa7\xf6\x38\xf0\x42\xf0\xe3\x96\xe1\xa1\x70\x3e"
+DATA ·templatesData+9408(SB)/16,$"\xea\xae\x30\xa0\x74\xbe\xed\x47\x23\x9e\x64\x20\x4d\x8e\xa7\x4e"
+DATA ·templatesData+9424(SB)/16,$"\x95\x81\x43\xbc\xf0\x8b\xf1\x59\x0c\x27\x8f\xa4\x1e\xea\x8e\x1d"
+DATA ·templatesData+9440(SB)/16,$"\xf5\xe2\xcd\x41\x42\x6f\xd8\x16\x92\x7e\xd8\xdd\xd9\xfb\xd8\xbe"
+DATA ·templatesData+9456(SB)/16,$"\x0a\x7b\xb3\x98\x6d\x93\xd4\x4e\xd2\xf2\xf0\x3d\xe1\x38\xcd\x19"
+DATA ·templatesData+9472(SB)/16,$"\x6e\x9c\x99\xf7\x47\x68\xe7\x92\x3b\x77\xf0\xa0\x4c\xf0\xed\xbb"
+DATA ·templatesData+9488(SB)/16,$"\xad\x04\x1a\xc8\xb9\x64\x3d\x00\xb0\x95\x99\x82\xcc\xee\x8e\x41"
+DATA ·templatesData+9504(SB)/16,$"\x42\x95\xe0\xa3\xaa\xe2\x66\x06\x1b\xd0\x5e\x2d\xfa\xb2\x65\x69"
+DATA ·templatesData+9520(SB)/16,$"\x1a\x06\x7c\x2b\x5b\x93\x04\xb9\xb5\x7d\xea\xb0\xb6\x75\x8c\xed"
+DATA ·templatesData+9536(SB)/16,$"\x1a\x2b\x95\x2c\xba\xad\x6e\x63\x34\xbb\x89\xa5\x7e\x73\x53\xed"
+DATA ·templatesData+9552(SB)/16,$"\xb8\x22\xb1\x8e\xb5\x4a\x0d\xac\x69\xaf\x82\x89\x6f\xb0\xdf\xd8"
+DATA ·templatesData+9568(SB)/16,$"\x62\x57\xec\x3a\x9b\xbc\x92\x7c\x23\xb3\x75\x0f\x89\x1c\x2c\x7a"
+DATA ·templatesData+9584(SB)/16,$"\x0b\xb3\x75\xed\xf6\x45\x95\xf5\x7a\xc8\x6d\xcb\xf8\x5e\x54\xd9"
+DATA ·templatesData+9600(SB)/16,$"\x5f\xe2\x20\xe1\xb6\x94\x78\x4c\xb6\xef\xc0\x59\xb6\xc8\xdf\xd4"
+DATA ·templatesData+9616(SB)/16,$"\x71\xbe\xa8\xb2\xf5\x17\x31\x28\xc4\xe9\xa2\xba\x0e\x26\x25\x48"
+DATA ·templatesData+9632(SB)/16,$"\xd0\x36\xda\xdd\xb1\x9c\x6a\x71\x2b\x9f\xba\x95\x39\x16\x5a\x6c"
+DATA ·templatesData+9648(SB)/16,$"\xe6\x56\x6f\x64\xa1\xd6\x2f\x2e\x9c\xc4\xe7\x96\x5e\x15\x06\x23"
+DATA ·templatesData+9664(SB)/16,$"\x0a\xc2\x4d\x70\x5e\x37\x30\x9a\xcf\x5d\xa4\x16\xbd\x3e\x76\xfb"
+DATA ·templatesData+9680(SB)/16,$"\xfa\x90\xbe\xe2\xbc\x20\xaf\x9b\x7e\x9e\x52\xab\x42\x9f\x4b\x6b"
+DATA ·templatesData+9696(SB)/16,$"\x0f\x4d\x52\x71\x73\x61\x8a\x57\xb9\xfe\xed\xdd\x1b\x8a\xa2\xa9"
+DATA ·templatesData+9712(SB)/16,$"\xf7\x09\xe2\xa8\x9f\x57\x59\xd6\xd7\xbd\x48\xaa\xa1\x54\x39\x10"
+DATA ·templatesData+9728(SB)/16,$"\xa5\x30\xfe\xcc\x17\xa1\x75\x9d\xc9\x9c\x97\x77\x5c\xcb\xea\xb8"
+DATA ·templatesData+9744(SB)/16,$"\xbd\x64\xcd\x44\x8a\xdd\x61\x3d\xc6\x26\xe1\xa7\xcd\xc0\xc7\xdc"
+DATA ·templatesData+9760(SB)/16,$"\xf1\x53\xb6\xcb\xf7\x86\x3a\x9b\x7f\x9b\xc2\xdd\xa6\x7d\xb1\x5b"
+DATA ·templatesData+9776(SB)/16,$"\x26\xa9\x1c\x6c\x49\x00\x1d\x36\xbb\xb9\xa0\xb6\x0b\x50\xd3\x28"
+DATA ·templatesData+9792(SB)/16,$"\xa7\x94\x97\xa2\xf6\xc6\xe2\x6e\x21\xbf\x4c\xa5\x93\x8b\x9d\x96"
+DATA ·templatesData+9808(SB)/16,$"\x5c\xfa\xda\x95\x14\x4d\x8d\xf7\xa4\xbb\xf9\x5d\xab\x6a\xa0\x68"
+DATA ·templatesData+9824(SB)/16,$"\xda\x48\x8c\x50\x2d\xc1\x2b\x58\x4b\xe0\x4f\x28\x08\x60\xd5\x4b"
+DATA ·templatesData+9840(SB)/16,$"\xac\xbb\x1d\xbc\x71\x73\xe6\x84\x4a\x5b\xb1\x79\xd3\x2b\xc3\xfc"
+DATA ·templatesData+9856(SB)/16,$"\xf8\x8a\xdf\x68\x87\xa6\x71\xe7\x85\xf6\xe3\xab\xd6\x6f\x01\x16"
+DATA ·templatesData+9872(SB)/16,$"\x55\x86\x9b\xe2\x0a\xaa\x0a\x82\xb9\xf1\x5d\xa9\x35\x4b\x21\x09"
+DATA ·templatesData+9888(SB)/16,$"\xff\xdd\x37\xe7\x26\x53\x3d\x35\x7f\x3d\x4b\xab\xdf\x18\xed\xd8"
+DATA ·templatesData+9904(SB)/16,$"\x72\xea\xa1\x20\x28\xf9\xc2\x17\x89\x37\xde\x1b\x23\x6b\x94\x2f"
+DATA ·templatesData+9920(SB)/16,$"\x31\xb4\x59\xe7\x5b\x5d\xaa\x31\x22\x8b\xeb\xb0\x86\x9a\x37\x09"
+DATA ·templatesData+9936(SB)/16,$"\x08\x7a\x62\x83\x59\xb2\x42\x62\x04\x82\x59\x39\x12\xfa\x53\xbd"
+DATA ·templatesData+9952(SB)/16,$"\x1d\x90\x46\x45\x4b\x6b\x92\xf6\x48\x67\xa0\x73\x71\xa9\xac\x33"
+DATA ·templatesData+9968(SB)/16,$"\x33\x63\x71\x6c\xb5\x2f\x1a\xf5\x8c\x39\x85\x8e\x9b\x80\x1b\x4c"
+DATA ·templatesData+9984(SB)/16,$"\x19\x08\x0e\x2c\x72\x3e\x24\x0a\x7d\xec\xa2\xab\x5b\x37\x71\x50"
+DATA ·templatesData+10000(SB)/16,$"\xca\x29\x79\xfe\x15\x68\x66\xa4\x89\xb7\x77\xdb\x6e\xc9\xb5\x8f"
+DATA ·templatesData+10016(SB)/16,$"\xae\xaa\x96\x1c\xbd\x1a\x7f\x19\x1f\xe5\x2d\xc6\x0a\xff\xed\x12"
+DATA ·templatesData+10032(SB)/16,$"\xb6\x4d\xda\x50\x5b\x31\x9b\xdd\x2e\xbc\xde\xf3\x1b\x61\xce\xaf"
+DATA ·templatesData+10048(SB)/16,$"\xc1\xd3\xb4\x28\xfa\xd9\x1a\xdb\xf8\x74\xd3\x14\xc3\x47\x0d\x7e"
+DATA ·templatesData+10064(SB)/16,$"\x99\x5b\x6d\xeb\x94\x7b\xfe\x4d\x45\xe8\x8e\xdb\xf7\x56\xf0\x67"
+DATA ·templatesData+10080(SB)/16,$"\x35\x9a\xe0\x19\xa9\x78\x59\xb2\xce\x95\x30\x1b\x64\x06\x2d\x70"
+DATA ·templatesData+10096(SB)/16,$"\x2d\x0e\x15\xd6\xb7\x4e\xef\xb8\xaf\x3f\x86\xd6\x67\x7a\xb6\xb1"
+DATA ·templatesData+10112(SB)/16,$"\x3a\xcf\x4e\xf6\xa1\x59\x9a\x95\xa2\xa4\x77\xad\xa9\x43\xc1\xde"
+DATA ·templatesData+10128(SB)/16,$"\xd5\x5f\xdf\x1b\xdb\x4c\x3d\x2b\x54\x94\xad\xc1\xeb\xef\x66\x45"
+DATA ·templatesData+10144(SB)/16,$"\xd6\x9e\x55\xdb\x89\xb1\x74\xa6\x45\x3c\x49\x67\x20\x1b\x34\xdd"
+DATA ·templatesData+10160(SB)/16,$"\x1b\xeb\x2a\x5b\xaf\x50\xf0\xdd\xda\xd2\xb0\x74\x66\x17\xee\x7c"
+DATA ·templatesData+10176(SB)/16,$"\x31\x4a\x19\x9a\x85\x83\x93\x95\x3e\xee\xe4\xe0\x0b\x08\xbf\xb2"
+DATA ·templatesData+10192(SB)/16,$"\xad\x57\x1a\x35\x68\x03\x77\xe7\x95\x15\x7d\x99\xe4\x8f\x2f\x44"
+DATA ·templatesData+10208(SB)/16,$"\x94\x11\x15\x45\x88\x33\xac\xd1\xda\xe9\x15\x46\x74\xdf\x20\xd8"
+DATA ·templatesData+10224(SB)/16,$"\xb0\x46\x6a\xa7\x2d\xc6\x64\x91\x6b\x31\x78\x3d\xb6\xe7\x77\x7c"
+DATA ·templatesData+10240(SB)/16,$"\x93\x45\xde\x71\xc3\xe4\x1b\x08\xe7\x11\xee\x8c\xe6\x3a\x92\xf3"
+DATA ·templatesData+10256(SB)/16,$"\x2e\xa1\xbe\x3c\xef\x98\xbd\x1d\x2a\xd1\xd8\x2f\xdb\xf6\x4c\xf9"
+DATA ·templatesData+10272(SB)/16,$"\x28\xbd\x58\x35\x61\xe7\xd8\xec\x5b\x4d\x56\x7c\xe3\x4e\xd4\x8b"
+DATA ·templatesData+10288(SB)/16,$"\x1a\x56\xec\xef\x76\xe3\x4a\x02\x64\xee\x77\x76\xc0\x21\xcf\x5f"
+DATA ·templatesData+10304(SB)/16,$"\xa9\xc3\x9e\x75\x3d\x16\x9f\xf7\xa7\x2e\x48\x1f\x76\x9c\x07\x7b"
+DATA ·templatesData+10320(SB)/16,$"\xa8\xbe\xae\xd0\x94\xf3\x13\x44\xdd\xbf\x60\xe2\x27\x47\x92\x49"
+DATA ·templatesData+10336(SB)/16,$"\x3b\x48\xaf\x66\x24\x3b\xde\x86\x57\x67\x34\xb9\x66\x7a\x32\x9c"
+DATA ·templatesData+10352(SB)/16,$"\xdd\x66\x7a\x31\x1c\x47\xc5\x22\x89\x1d\xa0\xdd\x9d\x64\x8d\x99"
+DATA ·templatesData+10368(SB)/16,$"\x6e\xc6\xd3\x62\xa8\x0f\xfd\x56\x71\xe0\x9d\x36\x61\xa1\x2c\x4b"
+DATA ·templatesData+10384(SB)/16,$"\xff\x31\x24\x5e\x5e\x51\x79\xb9\xf8\x04\x1e\xf0\x63\x34\xd8\x72"
+DATA ·templatesData+10400(SB)/16,$"\xf0\x4e\x87\xcf\x16\x79\x8e\x9b\x30\x0c\x66\x17\xda\xb0\xe0\xb7"
+DATA ·templatesData+10416(SB)/16,$"\xf1\xc3\xb7\xf8\x82\x5f\x8a\x6e\x5e\xe3\x73\x5c\xc4\xf7\xf9\x56"
+DATA ·templatesData+10432(SB)/16,$"\xe1\xdf\x3c\x03\xd3\x95\x4c\x48\x5d\x25\x61\xd7\x44\xb4\x8a\x67"
+DATA ·templatesData+10448(SB)/16,$"\x17\xe2\xa2\x78\x2c\xff\xc0\xc9\xb2\xb3\xbb\xee\xab\x75\xd3\xdd"
+DATA ·templatesData+10464(SB)/16,$"\x4f\xc2\x83\xc9\x22\x57\xde\x51\xf4\x94\xed\xc0\xc3\x6c\xb1\x74"
+DATA ·templatesData+10480(SB)/16,$"\xe6\xea\x40\xec\x2a\xa0\x73\x9a\xd2\x53\x98\x29\xfc\x85\xae\xe1"
+DATA ·templatesData+10496(SB)/16,$"\xf1\xa2\x54\xc2\x29\xf2\xfc\xef\x74\x81\x33\x7a\x7f\xf4\x5a\xfd"
+DATA ·templatesData+10512(SB)/16,$"\xe6\x57\xfc\xf8\x17\x9f\xd4\x32\x3a\x00\x8d\x0f\x50\x80\x5a\x86"
+DATA ·templatesData+10528(SB)/16,$"\xff\x1a\x00\x0c\xb8\xcf\xfe\x27\x4c\x00\x00\x1f\x8b\x08\x00\x00"
+DATA ·templatesData+10544(SB)/16,$"\x00\x00\x00\x02\xff\xb4\x57\x4f\x6f\x1b\xb7\x13\x3d\xef\x7e\x8a"
+DATA ·templatesData+10560(SB)/16,$"\xc9\x1e\x82\xdd\x44\x59\x21\x57\xe5\xa7\xc3\x0f\x89\xdd\xba\x40"
+DATA ·templatesData+10576(SB)/16,$"\xea\xa0\x2a\x7a\x31\x8c\x80\x12\x67\x2d\xc6\x2b\x72\x41\x52\x4e"
+DATA ·templatesData+10592(SB)/16,$"\x05\x41\xdf\xbd\x98\xe1\x72\x97\xfa\x93\xd6\x4d\xdb\x8b\x2d\x91"
+DATA ·templatesData+10608(SB)/16,$"\xc3\x37\xc3\xf7\x86\x33\xa3\x4e\xac\x1e\xc5\x03\x02\x6e\x96\x28"
+DATA ·templatesData+10624(SB)/16,$"\x25\xca\x3c\x57\x9b\xce\x58\x0f\x65\x9e\x15\xca\x4c\x1b\x57\x84"
+DATA ·templatesData+10640(SB)/16,$"\x0f\xca\x6c\xbd\x6a\xe9\x8b\x46\x3f\x5d\x7b\xdf\xd1\x67\xc3\xdb"
+DATA ·templatesData+10656(SB)/16,$"\x9d\xf0\x6b\xfa\xef\x8c\xf5\xfc\xdf\x5b\xa5\x1f\x5c\x91\x57\x79"
+DATA ·templatesData+10672(SB)/16,$"\x3e\x9d\xc2\xcd\xed\xf5\x02\x2c\xfa\xad\xd5\x0e\x1a\xb7\x73\x20"
+DATA ·templatesData+10688(SB)/16,$"\x1c\x08\x0d\x0c\x5f\x5f\x2f\x26\xb0\x75\x4a\x3f\x80\xf2\x2e\xd8"
+DATA ·templatesData+10704(SB)/16,$"\x6e\xd0\xaf\x8d\x84\xaf\x6b\xd4\xa0\x3c\xa8\x4d\xd7\xe2\x06\xb5"
+DATA ·templatesData+10720(SB)/16,$"\x77\x11\xed\x93\x35\x4f\x4a\xa2\xad\xe1\xd6\xaf\xd1\x42\xa3\x5a"
+DATA ·templatesData+10736(SB)/16,$"\x04\xb7\x73\x1e\x37\x0e\x84\x45\x30\x1d\x6a\x94\xe0\xd7\xd6\x6c"
+DATA ·templatesData+10752(SB)/16,$"\x1f\xd6\x40\xe1\xd6\xd7\xaa\xc5\x05\xdb\x80\xd0\x12\x8c\x6e\x77"
+DATA ·templatesData+10768(SB)/16,$"\x84\x37\xa0\x43\x1f\x8c\x5f\x23\x03\xba\x88\x72\x64\xf1\x0b\x0a"
+DATA ·templatesData+10784(SB)/16,$"\xf9\x41\x59\x02\xab\xf3\x66\xab\x57\x1c\x50\xc9\xd7\x1a\x3d\x54"
+DATA ·templatesData+10800(SB)/16,$"\x01\x0c\xf6\x79\xa6\x1a\xe8\x26\x60\x1e\x61\x36\xe7\xcb\xd7\x65"
+DATA ·templatesData+10816(SB)/16,$"\x7a\x83\xea\x1d\x6d\xed\xf3\x2c\x0b\x04\x41\x57\x33\x5e\x95\x67"
+DATA ·templatesData+10832(SB)/16,$"\x87\x3c\xae\xbd\xa4\xf8\xaf\x17\x7b\x3a\x3e\x63\x90\x43\x7e\x60"
+DATA ·templatesData+10848(SB)/16,$"\x6a\xc3\x06\x74\x16\x1d\xf1\x03\xe2\xfc\xaa\x23\xd5\x29\x4d\xb9"
+DATA ·templatesData+10864(SB)/16,$"\xdf\x75\x18\x8f\x3b\x6f\xb7\x2b\x4f\x51\xf0\x35\x4e\x20\x7a\x57"
+DATA ·templatesData+10880(SB)/16,$"\xb7\x1d\x6a\x66\xc4\x31\x43\x5a\x6c\x50\x42\x33\xd2\x50\x3a\x78"
+DATA ·templatesData+10896(SB)/16,$"\x15\xf0\x2a\xb6\x2d\xc9\x02\x42\x2a\x54\x50\x12\x1f\xaa\xc5\x09"
+DATA ·templatesData+10912(SB)/16,$"\xa0\xb5\xc6\x56\x3d\x33\x2f\x1a\x57\xff\x26\x5a\x25\x3f\x09\xbf"
+DATA ·templatesData+10928(SB)/16,$"\xe6\x13\x55\x4a\x86\x56\xed\x04\x5e\x36\xae\xa6\xed\x2b\x3a\xb8"
+DATA ·templatesData+10944(SB)/16,$"\xbf\xed\x66\x50\x50\x18\xc5\x04\x68\x75\xc6\x91\x4c\xe0\xca\x5a"
+DATA ·templatesData+10960(SB)/16,$"\xa2\xa6\xbe\xb2\xf6\x46\x3f\x11\xe4\x81\x28\xcc\xb3\x26\x7a\x25"
+DATA ·templatesData+10976(SB)/16,$"\x01\x5c\xcd\x12\x70\x7c\xc5\xb4\x80\xd7\x7c\xba\xe2\x58\xc8\xe4"
+DATA ·templatesData+10992(SB)/16,$"\xc5\x9c\x7c\x72\x08\xb4\x12\x75\x43\x6b\xeb\xf2\x55\x1a\xc7\xa8"
+DATA ·templatesData+11008(SB)/16,$"\x5b\x46\xe7\xe6\x80\xe4\x39\xcf\xb2\xc3\xf7\x47\x8f\xd6\xf6\x31"
+DATA ·templatesData+11024(SB)/16,$"\x1f\xe9\xae\x5a\xdc\xd3\x9f\x19\xb3\x7d\x98\x10\x6e\x2a\x3f\x89"
+DATA ·templatesData+11040(SB)/16,$"\x2a\xa4\x74\x27\xd9\x09\xde\xa4\xe9\x90\x08\x4e\x9b\xa3\xe4\xa3"
+DATA ·templatesData+11056(SB)/16,$"\x45\xc0\xec\x21\xc0\xa2\x90\x41\xea\x95\xd1\x9e\xb3\xcb\x34\xfc"
+DATA ·templatesData+11072(SB)/16,$"\x5d\x2a\x8b\x2b\x6f\xec\x2e\x2a\xdf\xf4\xca\xab\x16\xab\x78\xbe"
+DATA ·templatesData+11088(SB)/16,$"\x5c\x99\xad\xf6\xa0\xb4\xaf\xa0\x6c\x95\xf3\x70\x77\xdf\xb8\xfa"
+DATA ·templatesData+11104(SB)/16,$"\x83\xb2\x57\xda\xdb\x5d\x50\x64\xcc\x85\x27\x61\x41\xe9\xc6\x38"
+DATA ·templatesData+11120(SB)/16,$"\xb8\xbb\x37\x21\x55\x6e\x74\x63\x72\x56\x86\x37\xc2\x89\x39\x34"
+DATA ·templatesData+11136(SB)/16,$"\x7c\x49\x19\x3d\x54\xef\xc2\xfa\x28\x1c\x3b\x9b\xc3\x46\x3c\x62"
+DATA ·templatesData+11152(SB)/16,$"\x79\xe2\xb3\x45\x5d\x32\x58\x55\xe5\x59\xd6\x18\x0b\x6a\xc2\xe8"
+DATA ·templatesData+11168(SB)/16,$"\x24\xb2\x15\xfa\x01\xfb\x20\x58\x58\x02\xba\x53\xf7\x30\x07\xd9"
+DATA ·templatesData+11184(SB)/16,$"\x23\xec\x69\xf7\x10\x54\x1e\x75\xea\x89\x8b\x46\xe9\x6b\x6c\xc6"
+DATA ·templatesData+11200(SB)/16,$"\xab\x80\xe8\x17\x62\x38\x41\x91\xe1\x54\xfa\x08\xc7\xfb\x07\xe8"
+DATA ·templatesData+11216(SB)/16,$"\x5f\xc9\x92\xcd\x97\x6a\xd4\x81\x9f\xf3\xc6\x48\xec\x65\x90\x03"
+DATA ·templatesData+11232(SB)/16,$"\x58\xc5\x27\xca\x2a\xfa\xff\x68\x24\xc2\x3e\xc6\x0b\xb2\xa6\x85"
+DATA ·templatesData+11248(SB)/16,$"\xb2\xaa\x83\x55\xef\x84\x83\x8c\x65\x9a\xf0\x87\xc8\x89\xa8\xe8"
+DATA ·templatesData+11264(SB)/16,$"\xf0\x82\x2f\xb2\x29\xc7\x27\x4e\x5f\xd3\x67\x3e\x38\x1d\x37\xc7"
+DATA ·templatesData+11280(SB)/16,$"\x0c\x56\x26\x2d\x5f\x7e\x3d\x76\xa2\xbe\x06\x7f\xa3\x7e\x81\x35"
+DATA ·templatesData+11296(SB)/16,$"\xc6\xa3\x04\xe1\xf9\x53\xe0\x92\xc1\x12\x1e\x19\xe0\x15\xff\xcb"
+DATA ·templatesData+11312(SB)/16,$"\x33\x32\x83\xbe\x1e\xf5\xce\x9b\x6d\xdb\xfe\x4c\x55\x6a\x65\xf4"
+DATA ·templatesData+11328(SB)/16,$"\x13\x5a\x0f\xa2\x77\x44\x0d\x8d\x5f\x26\x78\x73\x1c\x14\x2d\x8e"
+DATA ·templatesData+11344(SB)/16,$"\xf5\x8e\x1c\x56\x03\x4c\x69\xba\x1e\x7f\x02\xc7\xc5\x2f\xae\xfe"
+DATA ·templatesData+11360(SB)/16,$"\x9d\xda\x57\x14\x97\x8a\x87\xe9\x9e\x55\xf6\x86\x6e\x22\xfc\xba"
+DATA ·templatesData+11376(SB)/16,$"\xfe\xc9\x28\x5d\xba\x9a\x08\x08\x81\x55\xa9\x02\xcf\xa9\xeb\xe1"
+DATA ·templatesData+11392(SB)/16,$"\x9e\xcf\xab\xea\xc4\x46\x5a\x6f\x23\x39\xb1\xec\x85\x82\x3b\x54"
+DATA ·templatesData+11408(SB)/16,$"\xdc\xe4\xe1\x52\x0d\x60\x89\x7b\xd4\x3c\x54\xe1\xb1\x80\x33\x1e"
+DATA ·templatesData+11424(SB)/16,$"\xa9\x19\x0a\x38\x41\x9f\x3f\xff\x78\xf5\x70\x8c\xee\x99\x65\x87"
+DATA ·templatesData+11440(SB)/16,$"\x1e\xea\x7b\x0b\x7a\x58\xf9\x27\xa5\x9c\x7b\x01\x5a\xdb\x93\xbe"
+DATA ·templatesData+11456(SB)/16,$"\xf0\x94\xb8\xfd\x5b\x13\xe3\x4b\x93\xe8\x56\x56\x2d\x69\x1a\xfa"
+DATA ·templatesData+11472(SB)/16,$"\x0b\x31\x08\xe1\x44\x0c\x2e\x64\xa7\x8f\xf0\xa4\xc6\x9e\xf0\x7b"
+DATA ·templatesData+11488(SB)/16,$"\x46\xef\xa0\xf1\x39\xb1\x12\x9b\x7e\xd4\xaa\xdf\xb7\xc6\x51\xe1"
+DATA ·templatesData+11504(SB)/16,$"\xc8\x32\x35\xf8\x99\x87\x2d\x8e\xab\xba\x50\x1e\xa9\x6a\x93\xd7"
+DATA ·templatesData+11520(SB)/16,$"\xa4\xb1\x8c\xd7\xe3\x89\x2c\xf2\xa1\xbc\x1b\x7a\xce\xd9\xbd\x23"
+DATA ·templatesData+11536(SB)/16,$"\xcc\xc9\xdd\x97\x70\x77\xbf\xdc\x79\xbc\x74\xe7\x92\x6a\x7d\x7a"
+DATA ·templatesData+11552(SB)/16,$"\xef\x10\x76\x4a\x55\x9e\x55\xff\x39\x1b\xe7\x09\xdf\xb7\xb6\xfa"
+DATA ·templatesData+11568(SB)/16,$"\xc6\x51\xc3\xac\xd2\xec\xbb\x90\x6b\xc4\xdb\x73\x86\x9e\xec\x00"
+DATA ·templatesData+11584(SB)/16,$"\xd8\x3a\x0c\x68\xcb\x18\x4e\x98\xdf\xb9\x77\xfe\xbf\x6d\x4b\x8a"
+DATA ·templatesData+11600(SB)/16,$"\xae\xfa\x46\x23\x3b\x9f\x00\x82\x50\x43\xdf\x3f\x52\x4b\x00\x37"
+DATA ·templatesData+11616(SB)/16,$"\x5c\xd3\x24\xfb\xa8\xbd\x55\xe8\x80\x7e\x10\xa0\x84\xe5\x8e\xd9"
+DATA ·templatesData+11632(SB)/16,$"\x20\x94\x8b\x7a\xd2\xed\x8f\xe5\x7c\xde\xc0\xf0\xef\x26\x73\x10"
+DATA ·templatesData+11648(SB)/16,$"\x44\x2a\x3b\x4c\xeb\xb4\x5b\x1e\x4f\x55\x49\x99\xa0\x18\xa3\x2f"
+DATA ·templatesData+11664(SB)/16,$"\xa9\x6c\xb4\x2a\xdf\xbc\xad\x4e\x44\xf8\x53\x49\xa5\xb2\xcf\x53"
+DATA ·templatesData+11680(SB)/16,$"\xf5\x5b\x59\x44\x24\xd7\x8b\x56\xad\xb0\x0c\x21\x11\xc3\xa5\x9a"
+DATA ·templatesData+11696(SB)/16,$"\xc0\x97\x30\x7d\x2d\x8d\x69\x61\xdf\xeb\x05\xfd\x50\x53\x73\x4d"
+DATA ·templatesData+11712(SB)/16,$"\xae\xe0\x7f\x61\xe1\xcb\xb0\x70\xb8\xf4\x76\x7f\x68\xcd\xf2\x68"
+DATA ·templatesData+11728(SB)/16,$"\x34\xa0\x40\x79\x0e\x11\x6d\xdb\x37\xea\x8d\xf0\xab\x35\x15\xae"
+DATA ·templatesData+11744(SB)/16,$"\x4e\x78\x8f\x56\x9f\x29\x4d\x20\x65\xbf\x39\x2a\xcd\xc7\x90\xc6"
+DATA ·templatesData+11760(SB)/16,$"\xbe\xa4\x49\x26\x3a\x4f\xa7\xf0\x7e\x8d\xab\xc7\x08\x0b\xca\xc1"
+DATA ·templatesData+11776(SB)/16,$"\x57\x6c\xdb\x37\x8d\xb1\x1b\x94\x35\x33\xf2\x39\xea\xc0\xfd\xee"
+DATA ·templatesData+11792(SB)/16,$"\x23\x41\x46\x4f\x13\x28\x8a\xea\xdd\xe9\x70\xdf\xdf\x2e\xfc\x40"
+DATA ·templatesData+11808(SB)/16,$"\x30\x96\xef\x33\xce\x80\xb1\xcb\x70\x16\xc6\xce\x64\xb1\xed\xa3"
+DATA ·templatesData+11824(SB)/16,$"\xa6\x4c\x71\x5f\x95\x5f\xad\x79\x73\x25\x5c\x20\x84\x44\x09\x6d"
+DATA ·templatesData+11840(SB)/16,$"\x76\x16\xfa\x50\x0b\x73\x28\xea\x22\xda\x84\x3d\xb2\x2a\xa6\x45"
+DATA ·templatesData+11856(SB)/16,$"\x62\x42\x67\xef\xde\xce\xee\x07\x3b\xf6\xe2\xea\x1f\x85\xfb\x64"
+DATA ·templatesData+11872(SB)/16,$"\xb1\x51\xbf\x97\x21\x2f\xc2\xf9\xd7\xc5\xb4\xa8\x4e\x4f\xd3\x60"
+DATA ·templatesData+11888(SB)/16,$"\x1b\xb6\xab\xd7\x01\x49\x62\x23\xb6\x6d\x88\x84\x8a\xa9\xd2\x5b"
+DATA ·templatesData+11904(SB)/16,$"\x4c\x3a\xa1\x79\x9c\xc0\x67\x98\x5d\x66\xcc\x62\x9b\xe4\x79\x54"
+DATA ·templatesData+11920(SB)/16,$"\x68\x0e\xa2\xeb\x50\xcb\x28\x59\xb0\x1b\x33\x33\xe4\x61\x88\x3d"
+DATA ·templatesData+11936(SB)/16,$"\xda\x54\xa7\x99\xb4\xd8\x8e\x89\x24\x34\x5c\x2f\x60\x65\xac\x45"
+DATA ·templatesData+11952(SB)/16,$"\xd7\x19\x2d\xb9\xeb\x85\x79\xcb\x6d\x97\xde\x22\x26\x53\x1e\xbd"
+DATA ·templatesData+11968(SB)/16,$"\xb0\xb3\x1e\xb8\x5d\x96\x52\xd9\xe3\x71\x64\xf1\xac\x61\xc4\x6d"
+DATA ·templatesData+11984(SB)/16,$"\x97\xc5\x84\x40\x93\x51\xe4\x2c\x3f\xc6\xae\x1d\x9f\x1e\x79\x9b"
+DATA ·templatesData+12000(SB)/16,$"\xb3\xa8\xa9\x99\xeb\xe7\x8c\xf4\xa7\x1b\x45\xb8\xe7\x34\x9a\xc5"
+DATA ·templatesData+12016(SB)/16,$"\x7c\x9a\xf0\x75\x66\x3c\x2f\x0e\x3f\xe3\xfe\x18\x00\x3c\x84\x07"
+DATA ·templatesData+12032(SB)/16,$"\x9b\x81\x11\x00\x00\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc"
+DATA ·templatesData+12048(SB)/16,$"\x57\x4f\x6f\xe3\xb6\x13\x3d\x8b\x9f\x62\x42\x20\x80\x14\x08\xf2"
+DATA ·templatesData+12064(SB)/16,$"\xed\x77\xf8\x2d\x7c\xe8\x36\x71\x91\x43\x37\xc0\xda\xc0\x1e\x82"
+DATA ·templatesData+12080(SB)/16,$"\x60\x41\x5b\x23\x9b\x8d\x2c\xa9\x24\xe5\xc4\x0d\xf4\xdd\x8b\x21"
+DATA ·templatesData+12096(SB)/16,$"\x29\x59\xb2\x2d\x27\xe9\x61\xf7\xb0\xa1\x69\xce\x9b\xc7\x37\x7f"
+DATA ·templatesData+12112(SB)/16,$"\x38\xae\xc4\xea\x59\xac\x11\x70\xbb\xc4\x34\xc5\x94\x31\xb9\xad"
+DATA ·templatesData+12128(SB)/16,$"\x4a\x65\x20\x64\x01\x47\xa5\x4a\xa5\x39\x0b\xb8\x2c\x27\x99\x5d"
+DATA ·templatesData+12144(SB)/16,$"\x28\xcc\x72\x5c\x19\x5a\x1a\xd4\x46\x16\xeb\xde\x72\x92\x69\x5a"
+DATA ·templatesData+12160(SB)/16,$"\x71\x16\x31\x96\xd5\xc5\x0a\x16\xa8\xcd\xfd\xc3\x6c\x1e\x1a\xb8"
+DATA ·templatesData+12176(SB)/16,$"\xf1\x67\x92\x45\x04\x6f\x2c\xc8\xf4\x5e\xc3\xff\xa7\x60\xbf\xdd"
+DATA ·templatesData+12192(SB)/16,$"\x8a\x67\xbc\x7f\x98\xe9\x30\x8a\x18\x0b\x4c\xf2\xbd\x2e\x42\x4e"
+DATA ·templatesData+12208(SB)/16,$"\xb6\xb3\x39\x8f\x81\x90\x4e\x01\x02\x99\x01\x2a\x45\x18\xce\x6b"
+DATA ·templatesData+12224(SB)/16,$"\xe2\x0c\x42\x42\x8e\x81\xcb\x22\xc5\xd7\x64\x63\xb6\x39\x8f\x81"
+DATA ·templatesData+12240(SB)/16,$"\x6b\x34\x64\xab\xbb\x8d\x4c\xe6\xa8\x27\x7f\xe9\x49\xef\x5c\xf4"
+DATA ·templatesData+12256(SB)/16,$"\xc5\x22\x5e\x4d\xa1\x90\xb9\xf5\x11\x98\xe4\x8e\x34\x08\x51\xa9"
+DATA ·templatesData+12272(SB)/16,$"\x88\x05\x41\xc3\x82\xa6\x47\xf1\x87\xc8\x9f\x6f\xa5\x1a\xe7\xb8"
+DATA ·templatesData+12288(SB)/16,$"\x13\x0a\x72\xa9\x0d\x3c\x3e\x69\xa3\x64\xb1\x66\x2c\x08\x3a\xd6"
+DATA ·templatesData+12304(SB)/16,$"\x89\xb7\x6f\x29\x27\x2d\x50\x25\xcc\x06\x9c\x41\x0c\x29\x9d\xbc"
+DATA ·templatesData+12320(SB)/16,$"\x95\xea\xae\x30\x6a\x1f\x5b\x86\x36\x2e\x91\xfb\xe3\x78\x5a\x27"
+DATA ·templatesData+12336(SB)/16,$"\x53\x10\x55\x85\x45\x1a\xd2\xa7\x18\x08\x85\x48\x07\x0a\x4d\xad"
+DATA ·templatesData+12352(SB)/16,$"\x0a\x3a\xce\x02\xc7\x3f\xc0\xd7\x0a\x57\x86\x68\xb4\xcc\xde\x78"
+DATA ·templatesData+12368(SB)/16,$"\xd2\xe9\xd2\x17\x68\x4c\xac\x77\x24\x6e\xd8\x21\x44\x67\x04\xcd"
+DATA ·templatesData+12384(SB)/16,$"\x3a\xf1\xc0\xb1\xc3\x14\xea\xc2\xb1\xc2\xd4\xdf\xec\x7a\xc7\xed"
+DATA ·templatesData+12400(SB)/16,$"\x7d\x9d\xf2\x0e\xef\xca\x27\x60\x72\x8b\x58\xdd\xfd\x5d\x8b\xdc"
+DATA ·templatesData+12416(SB)/16,$"\xdf\xd6\xd9\x46\xc7\x5e\x6e\x65\x0a\x45\x69\xa0\xf5\x26\x34\x74"
+DATA ·templatesData+12432(SB)/16,$"\x5e\xd6\xa5\x09\xaf\x77\x91\xdf\x00\x5a\xf3\x18\x06\x70\xbd\x98"
+DATA ·templatesData+12448(SB)/16,$"\x67\xa5\x82\x9f\x31\x50\x84\x49\x37\x25\x8a\x35\x3a\xf5\xea\x95"
+DATA ·templatesData+12464(SB)/16,$"\xb1\x6e\x0b\xb1\x45\xa0\x7f\x3e\xd6\x41\x40\xc2\x0d\x77\x36\x42"
+DATA ·templatesData+12480(SB)/16,$"\x5b\x6a\xb0\x2c\xcb\xfc\x10\x08\x80\xc7\xa7\xe5\xde\x20\x0b\x1a"
+DATA ·templatesData+12496(SB)/16,$"\x42\x7a\xe3\xdf\x51\xa4\x33\x99\xe3\x89\xce\x99\xc8\x35\xc6\x60"
+DATA ·templatesData+12512(SB)/16,$"\xf7\xbe\xee\x0d\xea\x26\x1e\x18\xc0\xbc\x5e\x8e\xc7\xec\x5d\xeb"
+DATA ·templatesData+12528(SB)/16,$"\x59\x99\xa7\xa8\xfa\x99\x60\x54\x8d\x31\x05\xf0\xf8\xe8\x9f\x52"
+DATA ·templatesData+12544(SB)/16,$"\x6b\xaa\xff\x18\xf8\xd6\x2d\x5b\x2f\xa3\x26\xf7\xc5\x4e\xe4\x32"
+DATA ·templatesData+12560(SB)/16,$"\x25\x93\x21\xaf\x81\x45\x63\xd5\x74\x35\x66\x2b\x9b\x84\x1d\xad"
+DATA ·templatesData+12576(SB)/16,$"\xb1\x60\x19\x1f\x1a\x41\xd2\xba\xf2\x35\x65\xcd\xe9\x26\x36\xeb"
+DATA ·templatesData+12592(SB)/16,$"\xdb\x84\x9c\xf6\x12\x92\xf6\xec\xa9\x2e\x32\x6e\xbb\x97\x43\x1d"
+DATA ·templatesData+12608(SB)/16,$"\xfd\x6b\x0d\xa9\xcf\x27\x97\xa1\xbd\x6c\xe2\x03\x67\x04\xd0\xd0"
+DATA ·templatesData+12624(SB)/16,$"\xff\x0d\x60\xae\xf1\xe0\xea\xea\x13\xbe\xde\xa9\x8c\xce\x5d\x57"
+DATA ·templatesData+12640(SB)/16,$"\x24\xad\xcf\xf6\xae\x67\x8a\x65\xe9\xed\x06\xe5\x72\xa6\x5e\xd6"
+DATA ·templatesData+12656(SB)/16,$"\x68\x0e\x85\xb2\x2a\x0b\x83\x85\xd1\x10\x5e\xeb\x5e\xb9\x68\x2a"
+DATA ·templatesData+12672(SB)/16,$"\x97\x23\x40\xe6\x49\x34\x11\x0b\x9a\x4f\x55\x4d\x57\x22\x95\x30"
+DATA ·templatesData+12688(SB)/16,$"\x06\x55\x71\xd8\xf0\x0e\x0f\x4d\xb4\x2d\x92\x3f\xf2\xd2\xe6\xfa"
+DATA ·templatesData+12704(SB)/16,$"\x4d\x9b\x46\x87\x66\x76\xb1\x37\x35\x71\x67\x0e\xdf\x50\xbb\xe8"
+DATA ·templatesData+12720(SB)/16,$"\xf9\x8a\xb9\x99\x9c\x41\x3b\x57\x4c\x43\x94\xb2\x40\xc7\x64\xa5"
+DATA ·templatesData+12736(SB)/16,$"\x35\xff\xaf\x99\x2c\xb3\xb6\xfb\x74\xf9\x4c\xe8\xfd\x5c\xf6\xe2"
+DATA ·templatesData+12752(SB)/16,$"\x9c\x79\xaa\x7a\x41\xb4\x94\x3e\x98\x40\x1e\xf0\x90\x43\x3e\x63"
+DATA ·templatesData+12768(SB)/16,$"\x2f\xf5\xda\x0f\x65\x90\x25\xf1\xd1\x76\x7b\x29\x85\xfc\x63\x6b"
+DATA ·templatesData+12784(SB)/16,$"\xf1\xbe\x8a\xf4\xe2\x44\xf0\x73\x44\x3b\xfe\xf8\xd4\x3e\xef\xd3"
+DATA ·templatesData+12800(SB)/16,$"\xb3\xaf\x91\x45\x1f\x16\x77\x59\xc0\x52\xa4\xe0\x05\xe2\x67\xde"
+DATA ·templatesData+12816(SB)/16,$"\x7f\xd7\x6a\xc7\xd9\xe8\x7a\xd0\x9b\xe6\x75\x47\xc7\x35\xd7\xf1"
+DATA ·templatesData+12832(SB)/16,$"\x81\x23\xb3\xe0\x1f\x7d\x1b\xbb\x88\xd9\xfb\x1d\xcd\x43\x96\x04"
+DATA ·templatesData+12848(SB)/16,$"\xff\xfc\xa4\x73\x46\xd0\xde\x05\x92\x64\x32\xb8\xc3\x74\xf4\x0e"
+DATA ·templatesData+12864(SB)/16,$"\x27\xa2\x4a\xf7\x08\xd8\xf9\xa4\xa7\x6a\x73\x34\x33\xfe\x50\x34"
+DATA ·templatesData+12880(SB)/16,$"\xcd\xa4\xa7\xca\x4e\x26\xf0\x50\xe4\x7b\x30\x1b\x04\x6a\x95\xf3"
+DATA ·templatesData+12896(SB)/16,$"\xbd\x36\xb8\x85\x2d\x9a\x4d\x99\x6a\x28\x33\xfb\xcd\x8b\x33\x87"
+DATA ·templatesData+12912(SB)/16,$"\x9d\xc8\x6b\x04\xa1\x10\x76\x52\xcb\x65\x8e\x47\xb3\xa7\xeb\x44"
+DATA ·templatesData+12928(SB)/16,$"\x6f\x7d\xa4\xe6\xed\x30\x90\xda\x70\x3b\x1d\xca\x67\x27\xc3\x5e"
+DATA ·templatesData+12944(SB)/16,$"\x27\xe1\xcd\xc6\x98\x6a\x36\x8f\xbe\xc0\x55\xf9\xec\x8b\x7c\x26"
+DATA ·templatesData+12960(SB)/16,$"\x8c\xc8\xb3\x90\x13\x6c\x77\x6b\xa2\x01\xa2\x8f\xfe\x22\xcd\xa6"
+DATA ·templatesData+12976(SB)/16,$"\xac\x8d\xf5\x4e\x35\x01\xe1\xf5\x82\x0a\x81\x80\x7d\xc6\xff\x8a"
+DATA ·templatesData+12992(SB)/16,$"\xd1\x76\x18\xef\xa6\xbb\x65\xe7\x78\xaf\x93\x87\x0a\x8b\x90\x9f"
+DATA ·templatesData+13008(SB)/16,$"\xc1\x99\x9e\xe0\x64\x21\xa7\xd3\xef\x47\xfb\x92\xa7\xc1\x18\x41"
+DATA ·templatesData+13024(SB)/16,$"\xda\x5a\x14\x9d\xdc\x6b\xa2\x49\x12\x91\xb3\x6f\xa5\xb9\x7b\x95"
+DATA ·templatesData+13040(SB)/16,$"\xda\x44\x97\xfc\xfb\x99\x97\x96\x48\x87\xbd\xd2\xb6\xe5\xb4\x57"
+DATA ·templatesData+13056(SB)/16,$"\x6e\x33\xee\x10\xed\x7e\x9c\xe8\x47\x0a\xf1\xfb\x86\x2f\xe1\xff"
+DATA ·templatesData+13072(SB)/16,$"\xec\x00\x98\xfc\x96\xba\xd9\x82\x5f\x1c\x84\x39\xbd\x0b\x76\x6b"
+DATA ·templatesData+13088(SB)/16,$"\x2e\xff\xc1\x18\x34\x9a\x85\xa4\xb6\xbf\x95\x5b\x5c\xec\xab\x76"
+DATA ·templatesData+13104(SB)/16,$"\xfe\x5a\x88\x75\x3b\xf8\xd8\xcf\xbf\x97\xdb\x4a\xa1\xd6\x98\xc6"
+DATA ·templatesData+13120(SB)/16,$"\xfe\xed\x0b\x8f\xf6\xa3\x63\x1a\x27\xb1\x3f\xd9\xf8\x14\x99\x93"
+DATA ·templatesData+13136(SB)/16,$"\xe9\xd0\xaf\x8f\xdd\x7e\xe8\xf7\xc0\x2f\x91\xc1\x4e\xab\x3d\x46"
+DATA ·templatesData+13152(SB)/16,$"\xdc\xb6\x3a\xe7\xbe\xf3\xc8\x82\x60\x84\x33\x0b\x46\xa0\x06\x3f"
+DATA ·templatesData+13168(SB)/16,$"\x85\xc6\xa0\xce\xdb\xdb\xe1\x76\xd4\x8c\xbb\xf5\x90\x44\x70\x12"
+DATA ·templatesData+13184(SB)/16,$"\x49\x07\xec\x93\x38\x63\x0d\xfb\x77\x00\x74\xa6\x79\x11\xa8\x0f"
+DATA ·templatesData+13200(SB)/16,$"\x00\x00\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x58\xdd\x73"
+DATA ·templatesData+13216(SB)/16,$"\xdb\x36\x12\x7f\x26\xff\x8a\x2d\x1f\x1a\x32\xa6\x29\xa7\x97\xe6"
+DATA ·templatesData+13232(SB)/16,$"\xc1\x1e\xb5\x93\x6b\x9d\xc6\x33\x6d\x2f\x8d\xd3\xeb\x83\xc7\x73"
+DATA ·templatesData+13248(SB)/16,$"\x03\x93\x4b\x09\x67\x0a\x90\x01\x50\x8a\xae\xf5\xff\x7e\xb3\x0b"
+DATA ·templatesData+13264(SB)/16,$"\xf0\x4b\x92\x73\x69\x2f\x0f\xad\x0c\x62\x3f\xf0\xdb\xdf\x7e\x00"
+DATA ·templatesData+13280(SB)/16,$"\x6b\x51\xde\x8b\x05\x02\xae\xee\xb0\xaa\xb0\x8a\x63\xb9\x5a\x6b"
+DATA ·templatesData+13296(SB)/16,$"\xe3\x20\x8d\xa3\x44\xa1\x9b\x2d\x9d\x5b\x27\x71\x94\x68\x4b\xff"
+DATA ·templatesData+13312(SB)/16,$"\x5d\x0b\xb7\xa4\xff\x5b\x67\x4a\xad\x36\xe1\xa7\x54\x0b\x9b\xc4"
+DATA ·templatesData+13328(SB)/16,$"\x59\x1c\xcf\x66\xf0\x56\xa8\xaa\x41\x03\x16\xcd\x06\x6d\xaf\x17"
+DATA ·templatesData+13344(SB)/16,$"\x96\xbc\x0e\x4e\xfb\x2f\xf0\x46\x36\x78\xbd\xb3\x0e\x57\xb1\xdb"
+DATA ·templatesData+13360(SB)/16,$"\xad\xb1\x97\x93\xca\xa1\xa9\x45\x89\xf0\x7b\x1c\x91\xf1\x22\x7c"
+DATA ·templatesData+13376(SB)/16,$"\x89\xa3\xd9\x0c\xae\xd1\xfd\xac\xdd\x1b\xdd\xaa\x6a\x30\xe4\x40"
+DATA ·templatesData+13392(SB)/16,$"\xb0\x7a\x34\xa4\xfe\x0e\xa1\x14\x4d\x83\x15\xd4\xda\x80\xd2\x50"
+DATA ·templatesData+13408(SB)/16,$"\xd3\xee\x38\x3a\x14\x4d\xc7\xea\xb3\x4e\xff\x3b\x34\x2b\x69\xad"
+DATA ·templatesData+13424(SB)/16,$"\xd4\xea\xf3\x2c\xac\xfb\xfd\xc0\xfa\xae\x9d\x70\xad\x7d\xa3\xcd"
+DATA ·templatesData+13440(SB)/16,$"\x9d\xac\x2a\x54\x71\x74\x4c\xe7\x11\xd3\x57\x35\x38\xd3\x22\x08"
+DATA ·templatesData+13456(SB)/16,$"\x55\x81\x5b\x22\xd4\xba\x21\x7b\x95\x46\x0b\x4a\x3b\x28\xb5\x72"
+DATA ·templatesData+13472(SB)/16,$"\x42\x2a\x90\xaa\xc2\x8f\xc5\xd2\xad\x1a\x30\xc8\x2e\xf9\x9d\xac"
+DATA ·templatesData+13488(SB)/16,$"\x44\xbb\x25\x9a\xad\xb4\x08\x06\x5d\x6b\x14\xbc\x3c\xfb\xdb\xd3"
+DATA ·templatesData+13504(SB)/16,$"\x6e\xbd\x67\xf9\x37\x2c\x6e\x53\x54\xe2\xae\x41\xb8\xd3\xba\xc9"
+DATA ·templatesData+13520(SB)/16,$"\xe2\x47\x0e\xe6\xf7\x72\x81\xd6\x5d\xa3\x73\x14\x19\x0b\x72\xb5"
+DATA ·templatesData+13536(SB)/16,$"\x6e\x70\x85\xca\x61\x05\x77\x3b\x10\x7d\xd4\xdc\x52\x38\xb0\xa8"
+DATA ·templatesData+13552(SB)/16,$"\x2a\x0b\x15\xcb\xc0\x12\x05\x69\xcd\xc1\xb6\xe5\x12\x84\x25\x75"
+DATA ·templatesData+13568(SB)/16,$"\x74\x2c\xad\x3a\xdf\xbc\x8e\x1f\xd0\x31\x15\x88\x14\xc6\x53\x61"
+DATA ·templatesData+13584(SB)/16,$"\x6a\x75\xcc\x07\x1f\x21\xff\x1d\x82\xbf\xef\x71\x6d\x4e\xc3\x12"
+DATA ·templatesData+13600(SB)/16,$"\x61\xf7\xfd\xc4\x3e\xb4\x56\xaa\x05\x5b\x16\xcd\x42\x1b\xe9\x96"
+DATA ·templatesData+13616(SB)/16,$"\x2b\xd6\x13\x8c\xbc\x7d\xfd\xd5\xd7\xaf\x40\x9b\xe1\xef\xaf\x5f"
+DATA ·templatesData+13632(SB)/16,$"\x7c\x95\x83\x50\x80\xab\xb5\xdb\x0d\x42\x50\x49\x4b\xf6\x2c\xe9"
+DATA ·templatesData+13648(SB)/16,$"\x5a\x31\x7c\x5e\x24\x1d\xb6\xf8\x54\x60\xf0\xf8\x20\xcc\x74\x43"
+DATA ·templatesData+13664(SB)/16,$"\xcb\x6d\xe9\x7a\x3e\x8f\x98\x1f\xa9\xc0\x48\xe0\x7f\x53\xba\x8f"
+DATA ·templatesData+13680(SB)/16,$"\x88\x75\xf0\xcd\xee\x2c\x0c\xff\xa6\xdf\xcc\x38\xaa\x1c\xce\x38"
+DATA ·templatesData+13696(SB)/16,$"\x0a\x41\x09\xff\xbc\x9f\x21\xc6\x93\x00\x40\x69\x50\x38\x04\xe1"
+DATA ·templatesData+13712(SB)/16,$"\x75\x2e\x83\x4e\x8e\x9d\xb4\xb0\x95\x4d\x13\xf2\xb7\x96\x0d\x42"
+DATA ·templatesData+13728(SB)/16,$"\x6d\xf4\x8a\xb1\xed\xd3\x7c\x38\x5c\x11\x73\xb4\xcc\x86\xf0\x5f"
+DATA ·templatesData+13744(SB)/16,$"\xfc\x47\xae\x59\xc4\x82\xd3\x50\x36\x12\x95\xb3\x9e\x35\xa2\x2c"
+DATA ·templatesData+13760(SB)/16,$"\x71\x4d\xf4\x5e\xad\x0d\x5a\x8b\x15\x33\x1d\x95\x03\x59\xb3\xee"
+DATA ·templatesData+13776(SB)/16,$"\xfe\x4f\x3b\xda\x34\xd1\x7e\xe9\xc4\xe2\xf4\x4e\x04\xd9\x4a\x3a"
+DATA ·templatesData+13792(SB)/16,$"\xa9\x95\x68\xc0\xe0\x43\x8b\xd6\x59\x52\x64\xd7\x58\xca\x5a\x92"
+DATA ·templatesData+13808(SB)/16,$"\x60\xdd\xaa\x72\x7a\xea\xb4\xb6\xb0\x17\x9a\xac\xa7\xf6\xef\x71"
+DATA ·templatesData+13824(SB)/16,$"\xe4\xf9\x0a\x5f\xfa\x78\xfe\x1e\x47\xd1\xb0\xf1\x1c\x00\xa0\xb6"
+DATA ·templatesData+13840(SB)/16,$"\x79\x1c\x51\x50\xce\xf7\xa3\x32\x31\x92\xd1\xae\x49\x78\xce\x39"
+DATA ·templatesData+13856(SB)/16,$"\xe7\xf3\x38\x7a\x0c\xd1\xf8\xeb\x05\x8e\x8f\x95\x5a\x78\xee\xbd"
+DATA ·templatesData+13872(SB)/16,$"\xcc\xe0\x58\xc1\x9b\x50\x25\xa3\xb3\xd9\xa2\xe7\xe0\x1c\x96\x83"
+DATA ·templatesData+13888(SB)/16,$"\x17\x7f\xb6\x0c\x7e\xd2\x8f\x23\xf5\xef\x98\x27\x23\xc6\x0f\xbe"
+DATA ·templatesData+13904(SB)/16,$"\xfc\xdf\x75\x71\x52\x16\xc9\x63\x56\xd3\x41\x03\x1d\xc7\x8f\xf9"
+DATA ·templatesData+13920(SB)/16,$"\xfd\x74\x81\xf4\x0e\x4f\x53\x6d\x1e\x4a\xd2\x00\xe2\x9f\xa9\x54"
+DATA ·templatesData+13936(SB)/16,$"\x47\x1d\x78\xaa\xc4\x78\xf3\x21\xab\xe7\xa3\xd2\xd6\x99\x36\x1b"
+DATA ·templatesData+13952(SB)/16,$"\x7c\xfb\xe1\xc3\xbb\xa1\x62\x4f\xf0\x1e\xaa\xea\x31\xab\x41\x36"
+DATA ·templatesData+13968(SB)/16,$"\xdd\x7a\x99\xf7\x68\xd7\x5a\x59\xfc\xcd\x48\x87\x26\x07\x03\xcf"
+DATA ·templatesData+13984(SB)/16,$"\xc3\x3a\xa7\x17\xfb\x52\x6a\x65\x9d\x0f\xc1\x3b\xb1\x40\x98\x43"
+DATA ·templatesData+14000(SB)/16,$"\x32\x1b\x02\x92\xc4\x71\xd4\xd2\xe8\x00\xe7\x73\x30\xc5\xaf\xef"
+DATA ·templatesData+14016(SB)/16,$"\x7f\x2c\xde\x09\xb7\x8c\x23\x59\xc3\x17\x61\x7e\x28\xde\x0a\xfb"
+DATA ·templatesData+14032(SB)/16,$"\xce\x60\x2d\x3f\xa6\xbc\x35\x87\x64\x96\xb0\xee\x20\x4a\x2a\x13"
+DATA ·templatesData+14048(SB)/16,$"\x38\x01\xfe\x8b\xf2\xa8\xd7\x03\xf3\x6e\xf1\x31\x8e\x23\x25\x56"
+DATA ·templatesData+14064(SB)/16,$"\x48\x76\x68\xa5\xf8\xae\x41\xa1\xbc\xc2\x2c\xe6\xba\x6f\xb0\x92"
+DATA ·templatesData+14080(SB)/16,$"\x06\x4b\x07\x45\x51\x8c\x5c\x04\xa7\x79\x85\xf7\x94\x42\x3d\x73"
+DATA ·templatesData+14096(SB)/16,$"\xd0\x5a\x0a\x99\xdf\x9d\x66\x70\x87\xa5\xa0\x25\x2e\x5a\x5b\xdd"
+DATA ·templatesData+14112(SB)/16,$"\x36\x15\xac\xc4\x3d\x32\x99\xd8\x41\x71\x67\x75\xd3\x3a\xca\xe6"
+DATA ·templatesData+14128(SB)/16,$"\xd9\x0c\xb6\x4b\x59\x2e\xc3\xbe\x3b\x04\x01\x6b\xa3\xef\x1a\x5c"
+DATA ·templatesData+14144(SB)/16,$"\x81\x69\x95\xa2\xa2\xd5\x32\x47\xaf\x9d\x91\x6b\x7f\x6e\x86\x63"
+DATA ·templatesData+14160(SB)/16,$"\x84\xc6\x75\x5b\x13\x1a\xc3\x39\xf3\x01\x60\x0f\x4c\xa3\x4b\xd1"
+DATA ·templatesData+14176(SB)/16,$"\xf4\x2e\x6e\x73\x30\x39\x24\xc5\x2c\xc9\xe2\x28\xd4\x2c\x0f\xc9"
+DATA ·templatesData+14192(SB)/16,$"\x46\x18\xa8\x40\x5b\xae\x46\x57\xaa\xd6\x71\x1c\xd5\x39\xa0\x31"
+DATA ·templatesData+14208(SB)/16,$"\x04\x94\x2d\xfe\xb1\x46\x95\x12\x6e\x19\xfb\x40\xeb\xf3\x39\x28"
+DATA ·templatesData+14224(SB)/16,$"\xd9\xb0\x95\x0a\x6b\x4a\xa6\xe2\xbb\x46\x5b\x4c\x49\x77\xe5\x65"
+DATA ·templatesData+14240(SB)/16,$"\xe7\x50\xf3\x58\x91\x66\xde\x4c\x10\xfd\x62\x10\xb5\x85\xd3\x44"
+DATA ·templatesData+14256(SB)/16,$"\xa5\x4b\x63\xb4\x09\x0e\xa2\x31\xfb\xfe\x8d\xc3\x42\xed\x41\x28"
+DATA ·templatesData+14272(SB)/16,$"\xad\x64\x29\x1a\xc6\xf5\x1c\x66\x20\x1c\xa0\xaa\x40\xd7\xe0\x77"
+DATA ·templatesData+14288(SB)/16,$"\x69\xb3\x83\xd6\x34\x5e\x72\xe0\x81\x68\xb6\x62\x67\xe1\x0e\x17"
+DATA ·templatesData+14304(SB)/16,$"\x52\x51\xb3\x72\x4b\x98\xc5\x51\x6b\x9a\x23\xbc\xab\x8a\x2b\xfb"
+DATA ·templatesData+14320(SB)/16,$"\xbd\x34\xa9\x47\x52\xd6\xa4\xef\xa6\x41\x95\xb6\xa6\xc9\x4e\x5f"
+DATA ·templatesData+14336(SB)/16,$"\xdc\xd2\x31\x9e\xcd\x9e\xf1\xd7\xa3\x40\x33\xbf\xfe\x2e\x2c\xb2"
+DATA ·templatesData+14352(SB)/16,$"\xc4\x49\xe2\x61\xef\xcf\x15\x3d\xc6\xd1\x23\x60\x63\xf1\x29\x03"
+DATA ·templatesData+14368(SB)/16,$"\xf3\xff\x61\x20\x29\x8a\x59\x72\x32\x35\x73\x68\xc2\xc3\x47\xc4"
+DATA ·templatesData+14384(SB)/16,$"\x0c\x7d\xd2\x12\x4c\x23\x62\x53\x71\xee\x51\xcb\xa9\x17\x52\x0b"
+DATA ·templatesData+14400(SB)/16,$"\x45\xe5\x8e\xc1\x40\x62\xcc\x89\x40\xc3\x0f\x46\xae\x02\x0f\x89"
+DATA ·templatesData+14416(SB)/16,$"\x1f\x21\x29\x4f\x06\x22\xc6\x51\x54\x1f\x50\x89\xbf\x66\xfe\xd4"
+DATA ·templatesData+14432(SB)/16,$"\x7b\x64\xea\xd8\x34\xa6\x53\x54\x55\xbd\x86\x7a\xa0\xd4\x51\xf1"
+DATA ·templatesData+14448(SB)/16,$"\x88\xda\x54\x55\xf1\xcf\x9a\x18\x58\xd3\xcf\xc7\x09\x18\xd7\x8e"
+DATA ·templatesData+14464(SB)/16,$"\xc6\x14\x31\x9c\xfa\x5b\x48\xb7\x08\x95\xac\x28\xad\x6b\xa9\x2a"
+DATA ·templatesData+14480(SB)/16,$"\x10\x93\x7e\x41\x83\x49\x76\x9c\x15\xfb\x35\x9e\x9d\xb0\x85\xdd"
+DATA ·templatesData+14496(SB)/16,$"\xd9\x62\x54\x28\x73\x60\x4e\x8f\xe2\x7d\x94\xfa\xda\x16\x97\xc6"
+DATA ·templatesData+14512(SB)/16,$"\x0c\xcd\x30\xf3\x6e\x4f\x72\x41\xd6\x60\xaa\x1c\xf4\x3d\xa3\x51"
+DATA ·templatesData+14528(SB)/16,$"\xa4\xcf\x0d\xb7\x87\xec\x82\x96\x48\xb3\xa9\x42\xdd\x7f\xdd\x37"
+DATA ·templatesData+14544(SB)/16,$"\x05\x02\xde\xaf\xf5\x18\x5c\xf9\xd9\xc9\x8f\x5c\x7d\x13\xb0\x20"
+DATA ·templatesData+14560(SB)/16,$"\xa6\x7d\xa0\xb5\xdd\xf0\x3e\xb4\xc8\xb5\xb0\x3c\xa5\xf9\x6b\x03"
+DATA ·templatesData+14576(SB)/16,$"\x77\x85\xef\x3c\xb5\xd8\xbb\xd0\x2f\x47\x2e\x4e\x3a\x79\xef\x67"
+DATA ·templatesData+14592(SB)/16,$"\xd8\x77\x08\xd3\x08\xa5\xae\x55\x05\xfd\x7e\xbe\x2c\x97\x58\xde"
+DATA ·templatesData+14608(SB)/16,$"\xc3\x4a\x57\xb2\x96\xa5\xa0\x59\x0e\x9c\x5c\x11\xd3\x06\x8f\x82"
+DATA ·templatesData+14624(SB)/16,$"\x40\xc0\xb5\x2a\x7e\x16\x2b\x4c\x33\xfa\xf5\x93\xae\x3e\x48\xff"
+DATA ·templatesData+14640(SB)/16,$"\x47\x9d\x0d\x73\xd5\x28\x18\xe1\xfa\x41\x58\x28\xad\x4e\xc3\x64"
+DATA ·templatesData+14656(SB)/16,$"\x58\x02\x6d\x00\xe4\x1d\x2b\xb4\x96\xda\x18\x75\x69\xcb\x17\x27"
+DATA ·templatesData+14672(SB)/16,$"\x28\x75\x85\xa4\x88\xd2\x49\xc0\x42\x6e\x50\xb1\x38\x31\xd3\x0b"
+DATA ·templatesData+14688(SB)/16,$"\x6d\x44\xd3\x62\x01\x57\xee\x19\x23\xae\x8d\x13\xca\x79\x70\xc7"
+DATA ·templatesData+14704(SB)/16,$"\xd6\xbb\xc1\x85\x94\x89\xd2\xb5\xa2\x69\x76\xc1\x25\x52\x54\x78"
+DATA ·templatesData+14720(SB)/16,$"\xc2\x64\x39\x58\xa9\x4a\x84\x95\x5d\xb0\x1b\x74\x76\x7f\x87\x03"
+DATA ·templatesData+14736(SB)/16,$"\x61\x46\x57\x28\xa7\x39\x88\x36\x67\x7d\xb4\x51\x5a\xa7\x0d\x95"
+DATA ·templatesData+14752(SB)/16,$"\xcf\x66\x07\x3f\xe8\x67\x76\x0a\x71\xa8\x91\xbd\xfc\xbf\x5b\xeb"
+DATA ·templatesData+14768(SB)/16,$"\x20\x79\x79\xf6\x92\x26\x22\xe0\x91\x28\xa1\x43\xb2\xba\x26\x9c"
+DATA ·templatesData+14784(SB)/16,$"\xcd\x16\xf0\x1b\x42\xa5\x29\x7f\xb6\x7c\x2a\x4d\xb8\x18\x07\x0d"
+DATA ·templatesData+14800(SB)/16,$"\x8a\x7b\xea\x66\x52\xd5\xda\xac\x7c\xb4\xa4\x9a\xc2\x68\x8b\xc3"
+DATA ·templatesData+14816(SB)/16,$"\x29\x63\x92\x1c\x9f\x35\x67\xf8\x12\xc1\x8a\x29\x3b\xe3\x68\x84"
+DATA ·templatesData+14832(SB)/16,$"\xc8\xf9\x7c\x7c\xc9\xbd\x52\x0e\x8d\x12\x8d\xe7\x2e\xdb\xf0\x89"
+DATA ·templatesData+14848(SB)/16,$"\xa5\x6d\x71\x65\x7f\xd6\xee\xf2\xa3\xb4\x2e\xa5\x46\xe4\x99\x3a"
+DATA ·templatesData+14864(SB)/16,$"\x28\x9a\xe8\xe9\x46\xc4\xae\x12\xf4\x83\xf2\xa8\xc3\x8d\xe6\xe7"
+DATA ·templatesData+14880(SB)/16,$"\x23\x05\xe1\x13\xdd\x80\x7d\x19\x4a\xc1\xe0\xcd\x93\xee\x8c\xee"
+DATA ·templatesData+14896(SB)/16,$"\xee\xc1\xa1\xd1\xbc\x3c\x76\x69\x32\x49\x1f\xf3\x6a\x70\x6b\x5c"
+DATA ·templatesData+14912(SB)/16,$"\x39\xd9\x54\x5f\xae\x46\x86\x3f\xe0\x47\x97\x0e\x5e\x65\xf9\x88"
+DATA ·templatesData+14928(SB)/16,$"\x8c\xdd\x4b\xc1\xa4\x81\x71\x7a\x50\x7e\xfd\xa4\x37\x58\x01\x9d"
+DATA ·templatesData+14944(SB)/16,$"\x52\x28\x54\x8e\x89\xee\x83\xcc\xf7\xb7\x2b\x37\x19\xe3\x37\x68"
+DATA ·templatesData+14960(SB)/16,$"\x1c\x18\x6c\x84\x93\x1b\x3f\x53\x71\x1d\xea\xe6\xaa\xb0\xd2\xc8"
+DATA ·templatesData+14976(SB)/16,$"\xfb\x61\x2e\x63\xf9\x40\xaf\xbd\x1e\xfa\x99\xa4\x52\xb8\xe5\xd9"
+DATA ·templatesData+14992(SB)/16,$"\x61\x34\x58\xcb\x1a\x1e\x86\x89\xe1\xbd\xd8\xfe\xd2\xa2\xd9\x5d"
+DATA ·templatesData+15008(SB)/16,$"\xc0\x03\xa1\x9c\x24\x0c\x72\x27\x76\x32\x87\xe4\x5b\x1a\x4b\x1f"
+DATA ·templatesData+15024(SB)/16,$"\x08\xc4\x68\x5b\xbc\xe5\x72\x9d\x66\xc5\x35\xba\x34\xf9\x51\xfb"
+DATA ·templatesData+15040(SB)/16,$"\x0a\x96\xf4\x86\x32\xda\xc4\xde\x84\x9d\x23\xa0\x19\xae\x11\x5a"
+DATA ·templatesData+15056(SB)/16,$"\xd9\xc1\x38\x6f\xd1\xc1\x46\x18\xa9\x5b\xdb\xbf\x70\xa0\x13\x8b"
+DATA ·templatesData+15072(SB)/16,$"\xbc\xbf\x25\xf3\xc3\x03\xd7\xad\xfe\x9a\x1e\xb2\xaf\x86\xae\x97"
+DATA ·templatesData+15088(SB)/16,$"\xfc\xb5\x19\x9f\xa6\x48\x54\xa5\xae\x28\xdf\xc3\x13\x42\x1c\x39"
+DATA ·templatesData+15104(SB)/16,$"\xb1\xf0\x6d\xc0\x89\x45\x68\xbf\x6c\xd8\x2d\x11\xec\x8a\xee\x87"
+DATA ·templatesData+15120(SB)/16,$"\xd6\x0d\x62\xb4\x1a\xae\xe5\xe1\xe2\x6f\x19\x6f\x9a\x8b\xea\xa2"
+DATA ·templatesData+15136(SB)/16,$"\xdb\x66\x33\xf8\x06\xce\x18\xe7\x11\xa0\xaf\xab\x2a\x4d\xfe\x29"
+DATA ·templatesData+15152(SB)/16,$"\xcc\x2e\xc9\x21\x79\xcd\xc2\xa7\x97\x41\x22\xa1\xe9\x9e\x14\xf5"
+DATA ·templatesData+15168(SB)/16,$"\x96\xe6\x41\x7f\xb7\x23\x35\x41\x53\xf1\x03\x05\xe6\x40\x3e\x87"
+DATA ·templatesData+15184(SB)/16,$"\xba\xe8\xfe\xb2\x69\x96\x5d\xb0\x4f\x9d\xba\xc1\xa1\x83\x10\x87"
+DATA ·templatesData+15200(SB)/16,$"\xc2\x3a\xa8\xca\x7b\x27\x38\xd9\xc2\xb1\xb0\xe2\x79\x99\xe2\xd1"
+DATA ·templatesData+15216(SB)/16,$"\x2d\xa4\x7b\xfb\x1a\x54\x0b\xbe\xcd\x48\xe5\x5e\xbd\x4c\x47\x98"
+DATA ·templatesData+15232(SB)/16,$"\x60\x95\x65\x43\xa2\xf6\x78\xad\xe4\x0a\x3f\xec\xd6\x78\x14\xae"
+DATA ·templatesData+15248(SB)/16,$"\x89\x73\xb4\x2b\xc9\x61\x24\xd2\x4f\x0a\x97\xa2\x5c\x82\xc1\x30"
+DATA ·templatesData+15264(SB)/16,$"\x14\xfa\x0a\xbe\x14\x16\xa4\xb3\xa0\xb7\x0a\x28\xae\x9d\x49\x27"
+DATA ·templatesData+15280(SB)/16,$"\x46\x48\x84\xb5\x23\x10\x11\x27\x28\x31\x4e\x29\x31\xba\xef\x61"
+DATA ·templatesData+15296(SB)/16,$"\xd6\xd9\xf7\x90\xde\x6e\x92\x1c\xc2\x83\x72\xf1\x4b\xab\x1d\xb2"
+DATA ·templatesData+15312(SB)/16,$"\x9d\x6c\xef\xb0\x7b\x43\xcf\xc4\x0f\xff\xcd\xf3\xd0\xff\x3e\x14"
+DATA ·templatesData+15328(SB)/16,$"\x18\x85\xc5\xc7\xd6\x7f\xff\x44\x64\x47\xf7\x75\xc6\x6e\x4f\xe1"
+DATA ·templatesData+15344(SB)/16,$"\x49\x32\x3f\x4f\x4e\xfc\xe2\x49\x72\x9e\x64\xc7\x74\xf4\xe2\xfd"
+DATA ·templatesData+15360(SB)/16,$"\x44\xad\x7f\x5d\xaf\xe9\x35\xe8\xe0\x40\x27\xc9\xbc\x53\x97\x4d"
+DATA ·templatesData+15376(SB)/16,$"\xe7\xd9\xcf\x1f\x91\x9e\x9a\x90\xea\x7e\x42\xaa\xf7\x26\x24\x5f"
+DATA ·templatesData+15392(SB)/16,$"\x61\xa6\xb9\xd2\x4f\x48\x7e\x84\x34\xd6\x81\xf6\xf3\x64\x9f\xa1"
+DATA ·templatesData+15408(SB)/16,$"\xfe\x76\x45\x4b\x4b\xb9\x58\x12\xf8\x0f\xad\x68\xa4\xdb\x81\x54"
+DATA ·templatesData+15424(SB)/16,$"\xdd\x53\xef\x5e\x8e\x85\x82\x95\x87\x97\x55\x59\x83\xd2\x0a\x79"
+DATA ·templatesData+15440(SB)/16,$"\x9a\xf1\xe6\xf9\x91\x44\x1b\x90\x15\x2a\x27\xdd\x2e\x27\x2d\xa4"
+DATA ·templatesData+15456(SB)/16,$"\xac\xbf\xd9\x10\x2d\x6d\xce\xf4\x14\xde\xb0\xe9\xec\xfa\x22\xb7"
+DATA ·templatesData+15472(SB)/16,$"\x97\xf3\xde\x62\xc0\x3e\x1f\x79\x7f\x73\xdb\xd5\xfb\x74\xaf\xa4"
+DATA ·templatesData+15488(SB)/16,$"\x75\x1d\x80\x18\xb2\x0c\xe5\x72\x3e\x0f\x14\x19\x0f\xea\xde\x14"
+DATA ·templatesData+15504(SB)/16,$"\x71\x8e\x9e\x01\xd2\x95\x58\xdf\x78\x0d\xb7\x75\xa3\x85\x7b\xf5"
+DATA ·templatesData+15520(SB)/16,$"\x92\x4a\x12\x4d\x8b\xff\x22\xc3\xce\xec\x68\xab\x11\x6a\x81\x3d"
+DATA ·templatesData+15536(SB)/16,$"\x17\xae\xd7\x8d\x74\x69\x07\x4b\x92\x87\xf7\x0e\x6e\x3e\x2f\x8a"
+DATA ·templatesData+15552(SB)/16,$"\xb3\x38\x8a\xd6\xc2\x88\x95\x1d\xdf\xc8\xbc\x0c\x2b\xcc\x21\xb9"
+DATA ·templatesData+15568(SB)/16,$"\xf0\x85\x2f\x98\xe1\xdd\x83\x19\x2f\x7c\xf3\xe2\xfc\xd6\xf3\x5b"
+DATA ·templatesData+15584(SB)/16,$"\xd6\xb0\x39\xb8\xdc\xad\x45\x89\x29\xef\xcc\x2e\xe0\xf0\x2d\x66"
+DATA ·templatesData+15600(SB)/16,$"\x93\x43\xf2\x30\x0f\x8e\xb1\x8a\xd1\x6d\x2f\xe4\xec\x3b\x61\x2c"
+DATA ·templatesData+15616(SB)/16,$"\xbe\xa1\x43\xa7\x9b\x9b\xaf\xce\x6f\x73\x78\xf5\x32\xbb\x38\xbc"
+DATA ·templatesData+15632(SB)/16,$"\xba\x45\x0f\x54\x02\xf9\xe7\xe3\x30\x7b\xc4\x51\xff\x64\x33\xe4"
+DATA ·templatesData+15648(SB)/16,$"\xc8\x8f\x7a\x8b\x26\x7d\xc2\x51\x7b\x73\x76\x9b\xf1\xb9\x67\x33"
+DATA ·templatesData+15664(SB)/16,$"\xf8\x78\xca\x6f\xcb\xd2\x93\xd5\x92\xa6\x10\x4f\x61\xf9\xd9\xd9"
+DATA ·templatesData+15680(SB)/16,$"\x57\x08\x36\x31\x9f\x43\xe2\xf7\xfb\x26\xee\x0d\xcf\x79\xdb\xe5"
+DATA ·templatesData+15696(SB)/16,$"\xb8\x4c\x79\x99\xb5\xc1\x0d\x75\xda\xee\xaa\xe3\x23\x7e\x43\x42"
+DATA ·templatesData+15712(SB)/16,$"\xb7\x17\xf0\x85\xbe\x87\x3f\xfe\x80\x07\xf8\xa6\xdf\xe8\x95\x8e"
+DATA ·templatesData+15728(SB)/16,$"\xb7\xc1\x9c\x06\x83\x51\x2e\x77\xdc\x26\x87\x47\xac\x6f\x55\x83"
+DATA ·templatesData+15744(SB)/16,$"\xd6\x02\x7e\x2c\x9b\xb6\xc2\x2a\xdf\x4b\x36\x61\x90\x8c\xd4\x68"
+DATA ·templatesData+15760(SB)/16,$"\x0c\x56\x20\xdc\x70\xd6\x8e\xfd\xd1\x90\x34\x13\x67\x93\x6e\x3d"
+DATA ·templatesData+15776(SB)/16,$"\xb9\xf5\xcf\x6d\xe1\x76\x26\x6b\x98\x48\x0c\x02\xcf\x93\xdb\x8b"
+DATA ·templatesData+15792(SB)/16,$"\x7e\x5b\xaf\x16\xe6\xf0\x62\x38\xc8\x5d\xa8\xb6\x67\xc5\xd9\x40"
+DATA ·templatesData+15808(SB)/16,$"\xf2\x2e\x8a\x9e\x7c\x83\xf3\x4c\xe9\x63\x18\x7a\x37\x7a\x53\x0f"
+DATA ·templatesData+15824(SB)/16,$"\x53\x27\x46\x81\x20\x8c\xd9\xe4\x97\x5f\xd2\xef\xf9\x80\x22\x0b"
+DATA ·templatesData+15840(SB)/16,$"\x76\xa6\x72\xbf\x69\x0e\xfe\xa9\x62\x04\x7c\xc8\xdb\xc7\xf8\xbf"
+DATA ·templatesData+15856(SB)/16,$"\x03\x00\x31\x2e\xa0\x9a\xef\x1c\x00\x00\x1f\x8b\x08\x00\x00\x00"
+DATA ·templatesData+15872(SB)/16,$"\x00\x00\x02\xff\xcc\x59\x5f\x6f\xdb\x38\x12\x7f\x96\x3e\xc5\x54"
+DATA ·templatesData+15888(SB)/16,$"\x40\x17\x52\xa2\x28\xd9\x62\x93\x87\x14\xbe\x43\xb6\x9b\x34\x87"
+DATA ·templatesData+15904(SB)/16,$"\xdd\xeb\x16\x89\xf7\x16\xb8\xa2\xb8\xd0\xe6\xc8\xd6\xad\x4c\x39"
+DATA ·templatesData+15920(SB)/16,$"\x24\x95\xc4\x5d\xf8\xbb\x1f\x86\x22\x65\x5a\x96\x9d\xb4\xc9\x01"
+DATA ·templatesData+15936(SB)/16,$"\xdb\x07\x57\xa2\x66\x7e\xf3\x87\xe4\xfc\x86\xcc\x9c\x8d\xff\x60"
+DATA ·templatesData+15952(SB)/16,$"\x13\x04\x9c\x8d\x90\x73\xe4\x61\x58\xcc\xe6\x95\xd4\x10\x87\x41"
+DATA ·templatesData+15968(SB)/16,$"\x34\x96\x8b\xb9\xae\x0e\xd5\x94\xbd\x39\x3e\x89\xc2\x20\x42\x31"
+DATA ·templatesData+15984(SB)/16,$"\xae\x78\x21\x26\x87\x23\xa6\xf0\xe4\x07\x1a\x12\xa8\x0f\xa7\x5a"
+DATA ·templatesData+16000(SB)/16,$"\xcf\xfd\x67\xf3\xa3\x51\x69\x1a\xac\x14\xfd\x4a\xcc\x4b\x1c\x9b"
+DATA ·templatesData+16016(SB)/16,$"\x01\xfa\x50\x88\x49\x14\x26\x61\x98\xd7\x62\x0c\x43\x54\xfa\x97"
+DATA ·templatesData+16032(SB)/16,$"\x6a\xcc\xca\xdf\x71\x74\x8d\xf2\x0e\x63\x0d\x7b\x56\x2a\x1b\x26"
+DATA ·templatesData+16048(SB)/16,$"\xf0\x67\x18\xf0\x42\xa6\x90\x2b\x38\x1d\xc0\x8c\xfd\x81\x17\x2a"
+DATA ·templatesData+16064(SB)/16,$"\x4e\xc2\x80\x63\x8e\x12\x2a\x95\x5d\xe1\xac\xba\xc3\xb3\xb2\x8c"
+DATA ·templatesData+16080(SB)/16,$"\x79\x21\x93\x30\x0c\x8c\xe0\x7b\xd4\x17\x45\x89\x06\x51\xc6\xb9"
+DATA ·templatesData+16096(SB)/16,$"\x4a\xc2\x40\x65\xd7\xa8\x3f\x54\xfa\xa2\xaa\x05\xbf\x64\x82\x97"
+DATA ·templatesData+16112(SB)/16,$"\x28\x63\x72\x36\xb3\x2f\x17\xb5\x18\x37\x03\x4e\x2a\x71\x6a\x1f"
+DATA ·templatesData+16128(SB)/16,$"\x51\xce\x0a\xa5\x8a\x4a\x6c\x55\xa4\x68\xe2\x7b\x30\xe3\x57\xa8"
+DATA ·templatesData+16144(SB)/16,$"\xe6\x95\x50\xf8\xbb\x2c\x34\xca\x14\x24\xec\xd9\xf1\xdb\x1a\x95"
+DATA ·templatesData+16160(SB)/16,$"\x36\x51\x05\x66\xe4\x5c\xca\x4a\xc6\xf7\x69\xa3\x77\xad\x99\xae"
+DATA ·templatesData+16176(SB)/16,$"\xd5\x10\x1f\x74\xec\xbd\x5f\x54\x72\x54\x70\x8e\x22\x49\xa1\x77"
+DATA ·templatesData+16192(SB)/16,$"\x38\x0c\x96\x09\x45\x9e\x57\x12\xfe\x93\x82\xd6\x94\x01\xc9\xc4"
+DATA ·templatesData+16208(SB)/16,$"\x04\xe1\xd3\x67\xa5\x65\x3d\xd6\xc6\xa2\x60\x33\x84\xf6\x9f\xd2"
+DATA ·templatesData+16224(SB)/16,$"\xb2\x10\x93\x30\x08\x6a\x59\x42\xcf\x70\x75\x87\x52\x16\x1c\x3b"
+DATA ·templatesData+16240(SB)/16,$"\xc3\xb2\x89\xe1\xfd\xbf\x8b\x39\x00\x8c\xaa\xaa\x0c\x83\xa0\xa4"
+DATA ·templatesData+16256(SB)/16,$"\x19\x6c\x21\xec\xa0\x44\xc1\x51\x5e\x54\x25\x47\xa9\xdc\x20\x3e"
+DATA ·templatesData+16272(SB)/16,$"\xcc\x71\xac\x9d\xe4\xa7\xcf\xa3\x85\xc6\x30\x08\x94\x09\xc9\x0d"
+DATA ·templatesData+16288(SB)/16,$"\x17\x42\x87\xc1\x92\x5c\xfe\x33\x2a\x04\xc7\x07\x18\x57\xb3\xb9"
+DATA ·templatesData+16304(SB)/16,$"\x44\xa5\x90\x47\x29\x44\x87\xf4\x13\xa5\xa0\x65\x8d\x29\xe4\xac"
+DATA ·templatesData+16320(SB)/16,$"\x54\xe8\x5e\x8c\xf8\xbb\x56\x7a\x2d\x63\xbf\xfe\xbc\x4c\x3d\xcc"
+DATA ·templatesData+16336(SB)/16,$"\x5a\xf4\xa3\x5a\xbc\x4d\xd8\x1f\x17\x1a\xd5\x2e\x44\x89\xbc\x90"
+DATA ·templatesData+16352(SB)/16,$"\xb4\xd2\x09\xcd\x0c\x65\x53\x3d\x2b\xff\xce\x71\x54\x4f\x06\x84"
+DATA ·templatesData+16368(SB)/16,$"\xb4\xdd\x71\x51\x94\x6b\xd0\xff\xac\xee\x90\xd3\xba\x63\x02\x85"
+DATA ·templatesData+16384(SB)/16,$"\x2e\x17\x6b\x86\x18\xe7\xa0\x4a\xa6\xa6\x1d\x4b\xf4\xba\xf6\xd6"
+DATA ·templatesData+16400(SB)/16,$"\x17\xcb\x13\x2d\x89\x4a\x43\x4e\xbb\xc0\xd8\x18\x31\xbe\x23\x3d"
+DATA ·templatesData+16416(SB)/16,$"\x5d\x48\xb7\x81\x5a\xa8\x66\x2d\x40\x6e\x16\x83\x01\xcc\x8b\x12"
+DATA ·templatesData+16432(SB)/16,$"\xd5\xe1\x7f\x55\xef\x5c\xda\xff\xba\xb0\xed\x92\xb7\xb8\x4f\x85"
+DATA ·templatesData+16448(SB)/16,$"\xeb\x77\xf2\xd7\x9f\xd7\x60\xd6\x67\xcf\xe1\x3d\x7b\xc2\x08\x68"
+DATA ·templatesData+16464(SB)/16,$"\x1d\x5a\xa1\xa6\xea\xa6\xcc\x1c\x1d\x3e\xdb\x80\x83\xdb\xc4\x7e"
+DATA ·templatesData+16480(SB)/16,$"\x64\x97\xec\x58\xce\xcd\x7e\xce\x1f\xc3\xf4\x7f\x77\x42\x2e\x4d"
+DATA ·templatesData+16496(SB)/16,$"\xfd\xd1\xd9\x55\x2d\x62\xad\x33\x2a\x44\x29\x98\x8a\xd9\xad\xf6"
+DATA ·templatesData+16512(SB)/16,$"\x61\x10\x04\xf7\x54\xbf\x1c\x8d\x64\x1f\xf0\xfe\x0a\xc7\x95\xe4"
+DATA ·templatesData+16528(SB)/16,$"\x28\xa9\xf0\x07\x81\xdc\xfc\x6c\x4a\x52\x1c\xbd\x3f\x1f\x92\x6f"
+DATA ·templatesData+16544(SB)/16,$"\x3a\xab\x65\x69\xf2\x67\xe4\x8b\x1c\x4a\x34\x76\x5d\x49\x4b\xe0"
+DATA ·templatesData+16560(SB)/16,$"\x6f\x70\x64\x5c\x0a\x02\x99\xfd\x76\xf5\x4b\xf6\x91\xe9\x29\x0c"
+DATA ·templatesData+16576(SB)/16,$"\xc0\x93\xa1\x8f\xcb\xd0\xea\x6b\x9d\xf9\x75\xcf\x69\x5e\x22\xe3"
+DATA ·templatesData+16592(SB)/16,$"\x28\xb3\x33\xce\xe3\xe8\x6c\x3c\xc6\xb9\x3e\x38\xb7\x2c\x49\x79"
+DATA ·templatesData+16608(SB)/16,$"\x9a\x7c\x29\xe6\x51\xb2\x02\xca\x55\xf6\x9b\x42\xc3\x76\xe4\x8d"
+DATA ·templatesData+16624(SB)/16,$"\x49\xb2\xf9\x6c\x38\xe6\xca\x2f\x97\xb1\xb1\xe8\x0d\xb4\x72\xf2"
+DATA ·templatesData+16640(SB)/16,$"\x0e\x2f\x87\xc3\x8f\xc4\x19\x32\xf1\xfc\xb3\x15\xf4\xd5\x00\x8e"
+DATA ·templatesData+16656(SB)/16,$"\xe0\xbb\xef\xe0\x9e\x48\xa8\x2e\x75\x9c\xd8\x89\x78\x57\x71\xa4"
+DATA ·templatesData+16672(SB)/16,$"\xaf\x2b\xd1\x26\x0a\xdd\x70\x50\x1e\x47\xaf\x6f\x33\x90\x46\x09"
+DATA ·templatesData+16688(SB)/16,$"\x06\xf0\x9a\xa7\x70\xcf\x84\x86\xd7\xbc\x49\x69\x33\x67\xbd\xb0"
+DATA ·templatesData+16704(SB)/16,$"\xe9\x0a\x34\xe9\xa6\xcd\xd6\xfb\x57\x03\x9a\x0e\x6b\xb2\xc8\xe1"
+DATA ·templatesData+16720(SB)/16,$"\x95\xed\x09\xb2\x9f\x10\xe7\xe7\xb7\x35\x2b\xe3\xfb\xec\xc7\x8a"
+DATA ·templatesData+16736(SB)/16,$"\x2f\x32\xb3\x84\xe2\x24\x5d\x29\x27\x56\xad\xe3\xea\xa4\x22\x3f"
+DATA ·templatesData+16752(SB)/16,$"\xe3\xd7\x2a\xb1\x9e\xd2\xe3\xba\xaf\xdb\x00\x0d\xdc\x32\xb4\x3f"
+DATA ·templatesData+16768(SB)/16,$"\x4b\x22\xd0\x70\xb9\xea\x47\x4c\x92\x7f\x2a\x26\xb4\xa6\x9e\xdb"
+DATA ·templatesData+16784(SB)/16,$"\x8d\x1c\x1e\x42\x83\xa4\x60\xc6\x16\x80\x82\x43\x21\xe0\x60\xf2"
+DATA ·templatesData+16800(SB)/16,$"\x25\x05\x3d\x45\xd0\x6c\x02\x85\x02\x45\x16\x39\x31\xd2\x94\x68"
+DATA ·templatesData+16816(SB)/16,$"\x9b\x87\xb4\x56\xce\x38\xa7\x1e\x26\x8e\x0e\x55\x9d\xe7\xc5\xaa"
+DATA ·templatesData+16832(SB)/16,$"\xc2\x77\x5e\x23\xbb\xf9\xae\x8b\x2f\x98\x82\x42\x3d\x2c\x28\xfc"
+DATA ·templatesData+16848(SB)/16,$"\x59\x31\xc3\xe1\x62\xee\xb6\xe6\x90\x4d\xf6\xa3\x83\xc9\x97\x55"
+DATA ·templatesData+16864(SB)/16,$"\x05\xf7\x77\xac\x79\x26\x7f\xb9\x71\x96\xa2\x33\xdb\x93\x33\xcd"
+DATA ·templatesData+16880(SB)/16,$"\x2c\x4b\x27\xb6\x07\x30\xb3\xa1\xea\x19\xc9\x34\xdd\x61\x76\x5d"
+DATA ·templatesData+16896(SB)/16,$"\xcf\xde\x1c\x9f\x18\xe1\xc4\x10\xbf\xae\xa5\x80\xa6\x4f\xcc\xae"
+DATA ·templatesData+16912(SB)/16,$"\x35\x77\x9b\x22\x33\x0f\x38\xac\xae\x0d\x52\xac\xea\xd9\xa7\xd3"
+DATA ·templatesData+16928(SB)/16,$"\xcf\x26\xfd\x5f\xd7\xbd\xf4\xf6\x2e\xbd\x2d\x8a\x6d\x3b\x58\x39"
+DATA ·templatesData+16944(SB)/16,$"\xa9\x64\xa1\xa7\x33\x5f\x0e\x29\xfb\x5d\x65\x9b\x80\xf5\xc1\x3b"
+DATA ·templatesData+16960(SB)/16,$"\x26\x17\x5d\x49\xdb\x9f\x6c\xf6\x10\x4d\x3d\x6c\x66\xfd\xfa\xf2"
+DATA ·templatesData+16976(SB)/16,$"\xec\xcd\xf1\x49\x0a\x37\xd1\x0d\xec\xb7\x13\x01\xfb\x70\x73\x60"
+DATA ·templatesData+16992(SB)/16,$"\xaa\xc3\x4d\x0a\x8d\xc1\xb8\xd3\xb8\x24\x29\x6c\x94\x14\x5b\x9c"
+DATA ·templatesData+17008(SB)/16,$"\xfb\xfa\x16\x3b\xa5\x8f\x19\xed\xda\x33\xb3\xbf\xc3\x94\xa8\x74"
+DATA ·templatesData+17024(SB)/16,$"\xb7\xf5\xea\xb2\xc1\xd3\xa2\xdd\x6a\xd8\x59\xa2\xa9\x68\xd6\x75"
+DATA ·templatesData+17040(SB)/16,$"\x63\x65\x6d\x89\xdb\xe8\xa2\xa8\x0f\x9a\xd6\xf4\x4d\xf3\x31\x5a"
+DATA ·templatesData+17056(SB)/16,$"\xf9\x6d\xad\xf5\xba\xbc\x13\x6e\x1d\xeb\xe9\xc4\x45\xe5\x79\xcb"
+DATA ·templatesData+17072(SB)/16,$"\xc9\x83\x0a\x77\x6c\x33\x84\x5a\xa3\x4c\xa8\xde\xbb\x02\xa3\xb3"
+DATA ·templatesData+17088(SB)/16,$"\x76\x71\x26\x2f\x4e\x7f\x2f\xca\x5f\xfd\xf4\x33\x35\x20\xe4\x94"
+DATA ·templatesData+17104(SB)/16,$"\x47\x0e\x0d\xb2\x33\x6f\x36\x1a\x79\xdd\xd8\x7b\x8f\x3a\x8e\xce"
+DATA ·templatesData+17120(SB)/16,$"\x35\x9b\x44\xc9\xdb\xe6\x5b\xc3\x4b\xe6\xb1\x8f\x95\x48\x96\x38"
+DATA ·templatesData+17136(SB)/16,$"\x49\x39\x4e\x52\x7e\x9d\x27\xb5\xd4\xe9\xaf\x13\x90\xd9\xb7\x1d"
+DATA ·templatesData+17152(SB)/16,$"\xc3\xff\x62\x72\x41\x86\xcd\xb7\xc6\xb0\x79\xec\x33\x4c\xb2\xdb"
+DATA ·templatesData+17168(SB)/16,$"\x0d\x93\x5a\xea\xf4\x3d\xc3\x96\xf3\x4e\x07\x10\x45\xeb\x0d\x48"
+DATA ·templatesData+17184(SB)/16,$"\xb3\x28\xfd\xf6\xc3\xca\x0e\x20\x52\x53\x76\xf0\xe6\xf8\x64\x70"
+DATA ·templatesData+17200(SB)/16,$"\x1a\xc1\x3e\xb4\xb2\xb0\x0f\xd1\x69\xb4\x16\x14\xef\x46\x74\x85"
+DATA ·templatesData+17216(SB)/16,$"\x73\x79\xd0\x2c\x27\x0a\x8c\x53\x54\x16\xb7\x2f\x28\x4f\x7c\x7b"
+DATA ·templatesData+17232(SB)/16,$"\x6c\x3c\x05\x8f\x2c\x97\xe1\x63\x71\xf4\xf9\xd5\x71\x29\xba\xbe"
+DATA ·templatesData+17248(SB)/16,$"\x3c\x33\x11\x46\xfb\xab\xf0\x7a\xa9\x7d\xd3\x39\xa7\xba\xe1\xe4"
+DATA ·templatesData+17264(SB)/16,$"\xca\x99\x7e\x52\xef\xb0\xba\x5b\xde\xcf\xe6\xf5\x91\xac\x74\x59"
+DATA ·templatesData+17280(SB)/16,$"\x90\x74\xc3\x8e\x71\x64\x47\x88\x04\x69\xd3\xe4\x2a\x8b\x9d\xb5"
+DATA ·templatesData+17296(SB)/16,$"\x33\xce\x69\xd3\x9f\xf1\x96\x09\xe3\xee\xa9\x6d\x24\xa3\x14\x1a"
+DATA ·templatesData+17312(SB)/16,$"\x88\xdd\x97\x18\x5b\x0b\x89\x5f\x82\xbf\xea\x36\xa0\x65\x39\x66"
+DATA ·templatesData+17328(SB)/16,$"\x4a\x80\x3f\xe2\xee\x7c\xbc\x11\x77\x7e\x77\x47\x77\x4b\x83\xa2"
+DATA ·templatesData+17344(SB)/16,$"\x12\xee\x70\xdb\x36\x24\xa6\xc8\xdb\x8a\x6c\x6a\x89\xad\x29\x29"
+DATA ·templatesData+17360(SB)/16,$"\x70\xcc\x4b\xa6\xd1\x0d\x44\x1b\xe7\x76\xab\xa5\x66\xac\x2c\x6d"
+DATA ·templatesData+17376(SB)/16,$"\x19\x5f\xd3\xa4\x5c\x75\xd2\x66\x55\xa8\x97\x2c\xf4\xc2\x69\xbc"
+DATA ·templatesData+17392(SB)/16,$"\xbd\x1d\x7c\x9f\x1d\x91\xcc\xdb\xdb\xc1\x51\x76\xfc\xa8\x49\x89"
+DATA ·templatesData+17408(SB)/16,$"\x79\x6d\xb9\xae\xd1\x49\xc1\xf7\x7d\xab\x1e\x13\xc6\xe6\x5e\xbf"
+DATA ·templatesData+17424(SB)/16,$"\x57\x4c\x2c\xc0\x43\xde\x73\x0e\x3d\x0a\x5b\x70\x14\xda\xc6\xe3"
+DATA ·templatesData+17440(SB)/16,$"\x3f\xf7\xe5\xd8\x7d\x07\x2f\x07\x6e\x8c\xf2\xd0\x44\x62\xf2\xf0"
+DATA ·templatesData+17456(SB)/16,$"\xfd\xa3\x18\x9e\xb7\x1e\xc6\x51\x17\xe3\x49\xce\xd3\xac\xb1\xba"
+DATA ·templatesData+17472(SB)/16,$"\xd4\xde\xa4\xd8\xa9\xd8\xe9\x82\x4b\x69\x23\xde\xce\xe1\x0f\x8f"
+DATA ·templatesData+17488(SB)/16,$"\xda\x7d\x38\xb0\x9f\xbd\xa7\x27\x28\xc0\xe6\xda\x39\x4a\xe1\x29"
+DATA ·templatesData+17504(SB)/16,$"\x10\x5f\xd5\x26\x3c\x93\xdf\x4d\xbf\xe7\x93\xbb\xad\xc9\xcd\xee"
+DATA ·templatesData+17520(SB)/16,$"\x5d\x3f\xda\xee\x26\xf8\x95\xd2\x4b\x70\xbc\xc5\xed\x92\xc0\xbb"
+DATA ·templatesData+17536(SB)/16,$"\x4a\x68\x14\x9e\x5d\xe2\x7c\x27\x6b\x79\xdf\xbd\xf6\xb1\x55\x57"
+DATA ·templatesData+17552(SB)/16,$"\x7f\x47\x1f\x60\x25\x52\x1f\xd3\xa7\x65\xdb\x85\xf4\xf5\x7c\xeb"
+DATA ·templatesData+17568(SB)/16,$"\xa9\x6c\x95\x7d\xa2\x6e\x7a\x90\x0d\xe5\xe8\xc0\xf2\x75\x1b\x45"
+DATA ·templatesData+17584(SB)/16,$"\x0b\xb8\x3a\x07\x6f\x6f\x7f\x6c\xf7\xf3\x8d\xad\x8f\xe9\x7c\x3a"
+DATA ·templatesData+17600(SB)/16,$"\x6d\x4f\xef\xd1\x6c\xfd\xec\x5b\xe4\x2b\x7e\xdf\xd9\x4c\xa4\x7e"
+DATA ·templatesData+17616(SB)/16,$"\x5b\xb2\xff\xe4\x03\xdd\x7e\x74\x1a\xfd\x7f\xfb\x90\x6f\xb9\x3f"
+DATA ·templatesData+17632(SB)/16,$"\x78\xd1\xeb\x83\xfe\x1e\x63\x58\xd1\xc6\x31\x66\x7a\x6e\xb6\x7a"
+DATA ·templatesData+17648(SB)/16,$"\x48\xdd\xee\x63\x25\xef\xcc\x9c\x65\xf1\x9e\xb9\x0a\x90\x5f\x41"
+DATA ·templatesData+17664(SB)/16,$"\xe0\x2b\x6a\x96\x12\x00\x00\xc9\xfa\xea\x4a\xdd\xbf\x4b\xf7\xaf"
+DATA ·templatesData+17680(SB)/16,$"\x74\x2b\x45\xe9\xf8\x50\xe9\xf3\x87\x42\xe9\x5d\xb7\xb7\xf3\xf6"
+DATA ·templatesData+17696(SB)/16,$"\x8f\x1f\xad\xda\xea\xef\x21\x3b\xef\x67\x9b\x58\x5a\xad\x77\x65"
+DATA ·templatesData+17712(SB)/16,$"\xd5\xbd\x92\xff\x87\xd0\x28\x05\x2b\x9b\x74\x98\xc4\xbd\xec\x7d"
+DATA ·templatesData+17728(SB)/16,$"\xa1\xd9\x11\xf2\x2e\xd3\xde\xcc\xdc\xdb\xbb\x55\x9a\x51\xf9\xd7"
+DATA ·templatesData+17744(SB)/16,$"\xbd\x44\x73\x0b\x2c\x5c\x86\xff\x1b\x00\xbd\xea\x07\x9b\xaa\x1b"
+DATA ·templatesData+17760(SB)/16,$"\x00\x00\x2d\x46\x43\x69\x67\x4c\x61\x35\x43\x72\x61\x39\x77\x63"
+DATA ·templatesData+17776(SB)/16,$"\x68\x4f\x37\x49\x76\x35\x43\x5a\x78\x74\x2d\x39\x41\x31\x66\x36"
+DATA ·templatesData+17792(SB)/16,$"\x4d\x33\x58\x6e\x78\x61\x68\x5f\x47\x62\x6e\x30\x69\x52\x69\x73"
+DATA ·templatesData+17808(SB)/16,$"\x6c\x73\x6c\x65\x4e\x66\x6e\x59\x5a\x33\x4a\x44\x4f\x30\x72\x47"
+DATA ·templatesData+17824(SB)/16,$"\x33\x39\x2d\x59\x63\x6a\x2d\x51\x57\x63\x2d\x77\x55\x38\x70\x7a"
+DATA ·templatesData+17840(SB)/16,$"\x59\x36\x34\x62\x4d\x39\x35\x5a\x37\x46\x67\x50\x69\x75\x36\x39"
+DATA ·templatesData+17856(SB)/16,$"\x79\x5a\x57\x47\x6e\x46\x64\x74\x79\x39\x65\x78\x56\x59\x6b\x6b"
+DATA ·templatesData+17872(SB)/16,$"\x46\x75\x61\x46\x62\x72\x6b\x67\x6c\x6b\x42\x2d\x46\x38\x70\x4a"
+DATA ·templatesData+17888(SB)/16,$"\x41\x79\x42\x4a\x61\x36\x6e\x76\x63\x6d\x2d\x33\x67\x79\x68\x31"
+DATA ·templatesData+17904(SB)/16,$"\x52\x37\x54\x67\x38\x76\x53\x59\x47\x6d\x59\x79\x32\x6f\x4c\x43"
+DATA ·templatesData+17920(SB)/16,$"\x45\x45\x39\x6b\x78\x62\x4c\x37\x71\x69\x34\x34\x44\x54\x70\x2d"
+DATA ·templatesData+17936(SB)/16,$"\x48\x33\x67\x6c\x36\x6c\x33\x34\x56\x4a\x74\x35\x4b\x72\x45\x74"
+DATA ·templatesData+17952(SB)/16,$"\x65\x78\x74\x2f\x70\x6c\x61\x69\x6e\x3b\x20\x63\x68\x61\x72\x73"
+DATA ·templatesData+17968(SB)/16,$"\x65\x74\x3d\x75\x74\x66\x2d\x38\x2f\x73\x65\x72\x76\x65\x72\x5f"
+DATA ·templatesData+17984(SB)/16,$"\x74\x65\x73\x74\x2e\x67\x6f\x2f\x69\x6f\x66\x73\x5f\x74\x65\x73"
+DATA ·templatesData+18000(SB)/16,$"\x74\x2e\x67\x6f\x2f\x66\x73\x5f\x74\x65\x73\x74\x2e\x67\x6f\x2f"
+DATA ·templatesData+18016(SB)/16,$"\x64\x69\x67\x65\x73\x74\x2e\x67\x6f\x2f\x73\x65\x72\x76\x65\x72"
+DATA ·templatesData+18032(SB)/16,$"\x2e\x67\x6f\x2f\x69\x6f\x66\x73\x2e\x67\x6f\x2f\x66\x73\x2e\x67"
+DATA ·templatesData+18048(SB)/1,$"\x6f"
+GLOBL ·templatesData(SB),(NOPTR+RODATA),$18049
@@ -10,11 +10,12 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"testing/fstest"
 )
 
 func TestBytes(t *testing.T) {
 	FS.Walk("/", func(path string, info embedded.FileInfo, err error) error {
-		if !info.IsDir() {
+		if info.Mode().IsRegular() {
 			t.Run(path[1:], func(t *testing.T) {
 				if tag := getTag(bytes.NewReader(info.Bytes())); tag != info.Tag() {
 					t.Errorf("checksum {%s} for file %s doesn't match recorded {%s}", tag, path, info.Tag())
@@ -27,7 +28,7 @@ func TestBytes(t *testing.T) {
 
 func TestString(t *testing.T) {
 	FS.Walk("/", func(path string, info embedded.FileInfo, err error) error {
-		if !info.IsDir() {
+		if info.Mode().IsRegular() {
 			t.Run(path[1:], func(t *testing.T) {
 				s := info.String()
 				if tag := getTag(strings.NewReader(s)); tag != info.Tag() {
@@ -41,7 +42,7 @@ func TestString(t *testing.T) {
 
 func TestOpen(t *testing.T) {
 	FS.Walk("/", func(path string, info embedded.FileInfo, err error) error {
-		if !info.IsDir() {
+		if info.Mode().IsRegular() {
 			t.Run(path[1:], func(t *testing.T) {
 				f, err := FS.Open(path)
 				if err != nil {
@@ -58,9 +59,24 @@ func TestOpen(t *testing.T) {
 	})
 }
 
+func TestFS(t *testing.T) {
+	var list []string
+
+	FS.Walk("/", func(path string, info embedded.FileInfo, err error) error {
+		if info.Mode().IsRegular() {
+			list = append(list, path[1:])
+		}
+		return nil
+	})
+
+	if err := fstest.TestFS(embedded.IOFS(FS), list...); err != nil {
+		t.Error(err)
+	}
+}
+
 func getTag(r io.Reader) string {
 	h := sha1.New()
 	io.Copy(h, r)
 	hash := h.Sum(nil)
-	return base64.RawURLEncoding.EncodeToString(hash[:]) + "-gz"
+	return base64.RawURLEncoding.EncodeToString(hash[:])
 }
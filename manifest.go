@@ -0,0 +1,59 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+)
+
+// LoadConfig create new config from a JSON manifest file.
+// Settings not in the manifest keep the values from New, relative paths
+// are relative to the directory containing the manifest.
+func LoadConfig(manifest string) (config *Config, err error) {
+	var b []byte
+
+	config = New()
+
+	if b, err = ioutil.ReadFile(manifest); err == nil {
+		decoder := json.NewDecoder(bytes.NewReader(b))
+		decoder.DisallowUnknownFields()
+		if err = decoder.Decode(config); err != nil {
+			err = fmt.Errorf("%s: %v", manifest, err)
+		}
+	}
+
+	if err == nil {
+		if base := filepath.Dir(manifest); base != "." {
+			config.Output = relativeTo(base, config.Output)
+
+			if len(config.CacheDir) > 0 {
+				config.CacheDir = relativeTo(base, config.CacheDir)
+			}
+
+			for i, entry := range config.Files {
+				config.Files[i] = relativeTo(base, entry)
+			}
+
+			for i, entry := range config.Sources {
+				config.Sources[i].Path = relativeTo(base, entry.Path)
+			}
+		}
+	} else {
+		config = nil
+	}
+
+	return
+}
+
+func relativeTo(base string, name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(base, name)
+}
@@ -0,0 +1,130 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const manifestContents = `{
+	"output": "assets/files",
+	"package": "assets",
+	"buildTags": "debug",
+	"cacheDir": "cache",
+	"noLocalFS": true,
+	"html": {"keepWhitespace": true},
+	"mimeTypes": {"webmanifest": "application/manifest+json"},
+	"sources": [
+		{"path": "www", "mount": "/static", "include": ["*.html", "*.webmanifest", "scripts/*"], "ignore": ["scripts/skip.js"], "minify": false},
+		{"path": "single<->/settings.html", "compress": false}
+	]
+}`
+
+func TestLoadConfig(t *testing.T) {
+	base, err := createFs()
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err == nil {
+		err = ioutil.WriteFile(filepath.Join(base, "www", "scripts", "skip.js"), []byte("var skip"), os.ModePerm)
+	}
+
+	if err == nil {
+		err = ioutil.WriteFile(filepath.Join(base, "www", "site.webmanifest"), []byte("{}"), os.ModePerm)
+	}
+
+	if err == nil {
+		err = ioutil.WriteFile(filepath.Join(base, "embed.json"), []byte(manifestContents), os.ModePerm)
+	}
+
+	if err == nil {
+		err = ioutil.WriteFile(filepath.Join(base, "bad.json"), []byte(`{"unknown": true}`), os.ModePerm)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	for _, test := range []struct {
+		name     string
+		manifest string
+	}{
+		{"Missing", filepath.Join(base, "missing.json")},
+		{"Unknown Field", filepath.Join(base, "bad.json")},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if config, err := LoadConfig(test.manifest); err == nil || config != nil {
+				t.Errorf("LoadConfig did not return an error")
+			}
+		})
+	}
+
+	config, err := LoadConfig(filepath.Join(base, "embed.json"))
+
+	if err != nil {
+		t.Fatalf("LoadConfig returned unexpected error %v", err)
+	}
+
+	t.Run("Values", func(t *testing.T) {
+		if expect := filepath.Join(base, "assets", "files"); config.Output != expect {
+			t.Errorf("Did not get expected Output got (%s) expected (%s)", config.Output, expect)
+		}
+
+		if expect := filepath.Join(base, "cache"); config.CacheDir != expect {
+			t.Errorf("Did not get expected CacheDir got (%s) expected (%s)", config.CacheDir, expect)
+		}
+
+		if expect := filepath.Join(base, "www"); config.Sources[0].Path != expect {
+			t.Errorf("Did not get expected Path got (%s) expected (%s)", config.Sources[0].Path, expect)
+		}
+
+		if expect := New().Minify; config.Minify != expect {
+			t.Errorf("Did not keep default Minify got (%s) expected (%s)", config.Minify, expect)
+		}
+
+		if !config.HTML.KeepWhitespace || !config.HTML.KeepEndTags {
+			t.Errorf("Did not get expected HTML options got (%+v)", config.HTML)
+		}
+	})
+
+	t.Run("Generate", func(t *testing.T) {
+		var gen generate
+
+		if err := gen.generate(config); err != nil {
+			t.Fatalf("Generate returned unexpected error %v", err)
+		}
+
+		var names []string
+		for _, f := range gen.Files {
+			names = append(names, f.name)
+
+			switch f.name {
+			case "/static/index.html":
+				if f.Compressed || f.Size != len("<html></html>") {
+					t.Errorf("File %s should not be minified", f.name)
+				}
+			case "/settings.html":
+				if f.Compressed {
+					t.Errorf("File %s should not be compressed", f.name)
+				}
+			case "/static/site.webmanifest":
+				if f.mimeType != "application/manifest+json; charset=utf-8" {
+					t.Errorf("Did not get expected mime type for %s got (%s)", f.name, f.mimeType)
+				}
+			}
+		}
+
+		expect := []string{"/settings.html", "/static/index.html", "/static/scripts/init.js", "/static/site.webmanifest"}
+		if !reflect.DeepEqual(names, expect) {
+			t.Errorf("Did not get expected files got (%v) expected (%v)", names, expect)
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"mime"
+	"strings"
+)
+
+// mimeTypes the built-in table of mime types by extension, used in place of the
+// system table so the output does not depend on the machine it is generated on
+var mimeTypes = map[string]string{
+	".aac":         "audio/aac",
+	".atom":        "application/atom+xml",
+	".avif":        "image/avif",
+	".bmp":         "image/bmp",
+	".css":         "text/css",
+	".csv":         "text/csv",
+	".eot":         "application/vnd.ms-fontobject",
+	".gif":         "image/gif",
+	".gz":          "application/gzip",
+	".htm":         "text/html",
+	".html":        "text/html",
+	".ico":         "image/x-icon",
+	".ics":         "text/calendar",
+	".jpeg":        "image/jpeg",
+	".jpg":         "image/jpeg",
+	".js":          "text/javascript",
+	".json":        "application/json",
+	".jsonld":      "application/ld+json",
+	".map":         "application/json",
+	".md":          "text/markdown",
+	".mjs":         "text/javascript",
+	".mp3":         "audio/mpeg",
+	".mp4":         "video/mp4",
+	".mpeg":        "video/mpeg",
+	".oga":         "audio/ogg",
+	".ogg":         "audio/ogg",
+	".ogv":         "video/ogg",
+	".opus":        "audio/opus",
+	".otf":         "font/otf",
+	".pdf":         "application/pdf",
+	".png":         "image/png",
+	".rss":         "application/rss+xml",
+	".svg":         "image/svg+xml",
+	".tar":         "application/x-tar",
+	".tif":         "image/tiff",
+	".tiff":        "image/tiff",
+	".ttf":         "font/ttf",
+	".txt":         "text/plain",
+	".wasm":        "application/wasm",
+	".wav":         "audio/wav",
+	".weba":        "audio/webm",
+	".webm":        "video/webm",
+	".webmanifest": "application/manifest+json",
+	".webp":        "image/webp",
+	".woff":        "font/woff",
+	".woff2":       "font/woff2",
+	".xhtml":       "application/xhtml+xml",
+	".xml":         "application/xml",
+	".yaml":        "application/yaml",
+	".yml":         "application/yaml",
+	".zip":         "application/zip",
+}
+
+// newMimeTypes returns the built-in table with the overrides, keyed by extensions
+// with or without the leading dot, applied
+func newMimeTypes(overrides map[string]string) map[string]string {
+	table := make(map[string]string, len(mimeTypes)+len(overrides))
+
+	for ext, mimeType := range mimeTypes {
+		table[ext] = withCharset(mimeType)
+	}
+
+	for ext, mimeType := range overrides {
+		table["."+strings.TrimPrefix(strings.ToLower(ext), ".")] = withCharset(mimeType)
+	}
+
+	return table
+}
+
+// withCharset adds charset=utf-8 to text mime types without a charset
+func withCharset(mimeType string) string {
+	mediaType, params, err := mime.ParseMediaType(mimeType)
+
+	if err == nil && len(params["charset"]) == 0 && isText(mediaType) {
+		mimeType += "; charset=utf-8"
+	}
+
+	return mimeType
+}
+
+// isText returns true for text, javascript, json and xml media types
+func isText(mediaType string) bool {
+	switch {
+	case strings.HasPrefix(mediaType, "text/"),
+		strings.HasSuffix(mediaType, "+json"),
+		strings.HasSuffix(mediaType, "+xml"):
+		return true
+	}
+
+	switch mediaType {
+	case "application/javascript", "application/json", "application/xml", "application/yaml":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,42 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"testing"
+)
+
+func TestMimeTypes(t *testing.T) {
+	table := newMimeTypes(map[string]string{
+		"WASM":   "application/x-wasm",
+		".data":  "application/octet-stream",
+		"txt":    "text/plain; charset=iso-8859-1",
+		".proto": "text/x-protobuf",
+	})
+
+	for _, test := range []struct {
+		name   string
+		ext    string
+		expect string
+	}{
+		{"HTML", ".html", "text/html; charset=utf-8"},
+		{"JavaScript", ".js", "text/javascript; charset=utf-8"},
+		{"Module", ".mjs", "text/javascript; charset=utf-8"},
+		{"Manifest", ".webmanifest", "application/manifest+json; charset=utf-8"},
+		{"SVG", ".svg", "image/svg+xml; charset=utf-8"},
+		{"PNG", ".png", "image/png"},
+		{"Override", ".wasm", "application/x-wasm"},
+		{"Extend", ".data", "application/octet-stream"},
+		{"Charset", ".txt", "text/plain; charset=iso-8859-1"},
+		{"Extend Text", ".proto", "text/x-protobuf; charset=utf-8"},
+		{"Unknown", ".unknown", ""},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if mimeType := table[test.ext]; mimeType != test.expect {
+				t.Errorf("Did not get expected mime type for %s got (%s) expected (%s)", test.ext, mimeType, test.expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"regexp"
+
+	"github.com/tdewolff/minify"
+	"github.com/tdewolff/minify/css"
+	"github.com/tdewolff/minify/html"
+	"github.com/tdewolff/minify/js"
+	minjson "github.com/tdewolff/minify/json"
+	"github.com/tdewolff/minify/svg"
+	"github.com/tdewolff/minify/xml"
+	"github.com/tdewolff/parse"
+)
+
+// MinifyFunc returns in, the contents of a file of the mime type, minified
+type MinifyFunc func(mimeType string, in []byte) ([]byte, error)
+
+// HTMLOptions are the options of the built-in HTML minifier, the quotes of
+// attribute values can not be kept, use a MinifyFunc for text/html to keep them
+type HTMLOptions struct {
+	KeepConditionalComments bool `json:"keepConditionalComments"`
+	KeepDefaultAttrVals     bool `json:"keepDefaultAttrVals"`
+	KeepDocumentTags        bool `json:"keepDocumentTags"`
+	KeepEndTags             bool `json:"keepEndTags"`
+	KeepWhitespace          bool `json:"keepWhitespace"`
+}
+
+// MinifyError is the error for a file that can not be minified
+type MinifyError struct {
+	// Name is the embedded name of the file.
+	Name string
+	// MimeType is the mime type of the file.
+	MimeType string
+	// Line and Column are the position of the error, zero if not known.
+	Line   int
+	Column int
+	// Err is the error returned by the minifier.
+	Err error
+}
+
+func (e *MinifyError) Error() string {
+	if pe, ok := e.Err.(*parse.Error); ok {
+		return fmt.Sprintf("%s:%d:%d: can not minify %s: %s", e.Name, e.Line, e.Column, e.MimeType, pe.Message)
+	}
+	return fmt.Sprintf("%s: can not minify %s: %v", e.Name, e.MimeType, e.Err)
+}
+
+func (e *MinifyError) Unwrap() error {
+	return e.Err
+}
+
+// minifyTypes mime types the built-in minifiers support
+var minifyTypes = map[string]bool{
+	"text/css":               true,
+	"text/javascript":        true,
+	"application/javascript": true,
+	"image/svg+xml":          true,
+	"text/html":              true,
+	"application/json":       true,
+	"application/xml":        true,
+	"text/xml":               true,
+}
+
+type minifier struct {
+	*minify.M
+	// types are the mime types supported
+	types map[string]bool
+	// options identify the built-in minifier options in the cache
+	options []byte
+	// custom are the mime types minified by a MinifyFunc, never cached
+	custom map[string]bool
+	// strict, if true, errors stop generation otherwise they are logged
+	strict bool
+	logf   func(format string, v ...interface{})
+}
+
+// newMinifier returns the built-in minifiers with the html options, the funcs
+// are added or replace them
+func newMinifier(options HTMLOptions, funcs map[string]MinifyFunc) *minifier {
+	m := &minifier{
+		M:      minify.New(),
+		types:  make(map[string]bool, len(minifyTypes)+len(funcs)),
+		custom: make(map[string]bool, len(funcs)),
+	}
+
+	m.options, _ = json.Marshal(options)
+
+	m.AddFunc("text/css", css.Minify)
+	m.AddFunc("text/javascript", js.Minify)
+	m.AddFunc("application/javascript", js.Minify)
+	m.AddFunc("image/svg+xml", svg.Minify)
+	m.AddFunc("application/json", minjson.Minify)
+	m.AddFunc("application/xml", xml.Minify)
+	m.AddFunc("text/xml", xml.Minify)
+	m.AddFuncRegexp(regexp.MustCompile(`^application/[^/]+\+json$`), minjson.Minify)
+	m.AddFuncRegexp(regexp.MustCompile(`^application/[^/]+\+xml$`), xml.Minify)
+	m.Add("text/html", &html.Minifier{
+		KeepConditionalComments: options.KeepConditionalComments,
+		KeepDefaultAttrVals:     options.KeepDefaultAttrVals,
+		KeepDocumentTags:        options.KeepDocumentTags,
+		KeepEndTags:             options.KeepEndTags,
+		KeepWhitespace:          options.KeepWhitespace,
+	})
+
+	for k := range minifyTypes {
+		m.types[k] = true
+	}
+
+	for k, fn := range funcs {
+		if mediaType, _, err := mime.ParseMediaType(k); err == nil {
+			m.AddFunc(mediaType, minifyFunc(mediaType, fn))
+			m.types[mediaType] = true
+			m.custom[mediaType] = true
+		}
+	}
+
+	return m
+}
+
+// minifyFunc adapts fn to the minify package
+func minifyFunc(mediaType string, fn MinifyFunc) minify.MinifierFunc {
+	return func(_ *minify.M, w io.Writer, r io.Reader, params map[string]string) error {
+		in, err := ioutil.ReadAll(r)
+
+		if err == nil {
+			in, err = fn(mime.FormatMediaType(mediaType, params), in)
+		}
+
+		if err == nil {
+			_, err = w.Write(in)
+		}
+
+		return err
+	}
+}
+
+// minify returns the data of the named file minified, the data unchanged if it
+// can not be minified and the minifier is not strict
+func (m *minifier) minify(c *cache, name, mimeType string, data []byte) ([]byte, error) {
+	fn := func() (b []byte, err error) {
+		if b, err = m.Bytes(mimeType, data); err != nil {
+			e := &MinifyError{Name: name, MimeType: mimeType, Err: err}
+			if pe, ok := err.(*parse.Error); ok {
+				e.Line, e.Column, _ = pe.Position()
+			}
+			err = e
+		}
+		return
+	}
+
+	var (
+		b   []byte
+		err error
+	)
+
+	if mediaType, _, _ := mime.ParseMediaType(mimeType); m.custom[mediaType] {
+		b, err = fn()
+	} else {
+		b, err = c.process(c.key([]byte("minify"), m.options, []byte(mimeType), data), fn)
+	}
+
+	if e, ok := err.(*MinifyError); ok && !m.strict {
+		m.logf("warning: %v", e)
+		b, err = data, nil
+	}
+
+	return b, err
+}
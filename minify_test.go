@@ -0,0 +1,158 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func TestMinifier(t *testing.T) {
+	var mimeTypes []string
+
+	upper := func(mimeType string, in []byte) ([]byte, error) {
+		mimeTypes = append(mimeTypes, mimeType)
+		return bytes.ToUpper(in), nil
+	}
+
+	fail := func(mimeType string, in []byte) ([]byte, error) {
+		return nil, fmt.Errorf("can not minify %s", mimeType)
+	}
+
+	keep := New().HTML
+	keep.KeepWhitespace = true
+
+	for _, test := range []struct {
+		name     string
+		options  HTMLOptions
+		funcs    map[string]MinifyFunc
+		mimeType string
+		data     string
+		expect   string
+	}{
+		{"HTML", New().HTML, nil, "text/html; charset=utf-8", "<ul> <li>a</li> <li>b</li> </ul>", "<ul><li>a</li><li>b</li></ul>"},
+		{"HTML Whitespace", keep, nil, "text/html; charset=utf-8", "<ul> <li>a</li> <li>b</li> </ul>", "<ul> <li>a</li> <li>b</li> </ul>"},
+		{"JSON", New().HTML, nil, "application/json", `{ "a": [ 1, 2 ] }`, `{"a":[1,2]}`},
+		{"Manifest", New().HTML, nil, "application/manifest+json; charset=utf-8", `{ "name": "app" }`, `{"name":"app"}`},
+		{"XML", New().HTML, nil, "application/xml", "<a>\n  <b>text</b>\n</a>", "<a><b>text</b></a>"},
+		{"Func", New().HTML, map[string]MinifyFunc{"text/x-vue": upper}, "text/x-vue; charset=utf-8", "<template/>", "<TEMPLATE/>"},
+		{"Replace", New().HTML, map[string]MinifyFunc{"text/css": upper}, "text/css", "a { color: red }", "A { COLOR: RED }"},
+		{"Error", New().HTML, map[string]MinifyFunc{"text/css": fail}, "text/css", "a { color: red }", "a { color: red }"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			m := newMinifier(test.options, test.funcs)
+			m.logf = t.Logf
+
+			b, err := m.minify(nil, "/test", test.mimeType, []byte(test.data))
+
+			if err != nil {
+				t.Errorf("minify returned unexpected error %v", err)
+			} else if string(b) != test.expect {
+				t.Errorf("Did not get expected got (%s) expected (%s)", b, test.expect)
+			}
+
+			for mediaType := range test.funcs {
+				if !m.types[mediaType] || !m.custom[mediaType] {
+					t.Errorf("Minifier does not support %s", mediaType)
+				}
+			}
+		})
+	}
+
+	if len(mimeTypes) != 2 || mimeTypes[0] != "text/x-vue; charset=utf-8" || mimeTypes[1] != "text/css" {
+		t.Errorf("Did not get expected mime types got (%v)", mimeTypes)
+	}
+}
+
+func TestMinifyErrors(t *testing.T) {
+	fail := func(mimeType string, in []byte) ([]byte, error) {
+		return nil, fmt.Errorf("not supported")
+	}
+
+	for _, test := range []struct {
+		name     string
+		strict   bool
+		mimeType string
+		data     string
+		expect   string
+	}{
+		{"Lenient", false, "application/json", "{\"a\": }", "/data.json:1:7: can not minify application/json: unexpected right brace character"},
+		{"Strict", true, "application/json", "{\"a\":\n 1 2}", "/data.json:2:4: can not minify application/json: expected comma character or an array or object ending"},
+		{"Func", true, "text/x-vue", "<template/>", "/data.json: can not minify text/x-vue: not supported"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var warnings []string
+
+			m := newMinifier(New().HTML, map[string]MinifyFunc{"text/x-vue": fail})
+			m.strict = test.strict
+			m.logf = func(format string, v ...interface{}) {
+				warnings = append(warnings, fmt.Sprintf(format, v...))
+			}
+
+			b, err := m.minify(newCache(t.TempDir()), "/data.json", test.mimeType, []byte(test.data))
+
+			if test.strict {
+				if e, ok := err.(*MinifyError); !ok {
+					t.Errorf("minify did not return a *MinifyError got (%v)", err)
+				} else if e.Error() != test.expect {
+					t.Errorf("Did not get expected error got (%s) expected (%s)", e, test.expect)
+				}
+			} else if err != nil {
+				t.Errorf("minify returned unexpected error %v", err)
+			} else if string(b) != test.data {
+				t.Errorf("Did not get data unchanged got (%s)", b)
+			} else if expect := "warning: " + test.expect; len(warnings) != 1 || warnings[0] != expect {
+				t.Errorf("Did not get expected warnings got (%v) expected (%s)", warnings, expect)
+			}
+		})
+	}
+}
+
+func TestDefaultMinify(t *testing.T) {
+	files := fstest.MapFS{
+		"data.json": &fstest.MapFile{Data: []byte(`{ "a": [ 1, 2 ] }`)},
+		"feed.xml":  &fstest.MapFile{Data: []byte("<a>\n  <b>text</b>\n</a>")},
+		"notes.txt": &fstest.MapFile{Data: []byte("some  notes")},
+	}
+
+	for _, test := range []struct {
+		name   string
+		add    string
+		expect map[string]int
+	}{
+		{
+			name:   "Default",
+			expect: map[string]int{"/data.json": len(`{ "a": [ 1, 2 ] }`), "/feed.xml": len("<a>\n  <b>text</b>\n</a>"), "/notes.txt": len("some  notes")},
+		},
+		{
+			name:   "JSON and XML",
+			add:    ",application/json,application/xml,text/xml",
+			expect: map[string]int{"/data.json": len(`{"a":[1,2]}`), "/feed.xml": len("<a><b>text</b></a>"), "/notes.txt": len("some  notes")},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var gen generate
+
+			config := New()
+			config.Output = filepath.Join(t.TempDir(), "assets", "files")
+			config.DisableCompression = true
+			config.Minify += test.add
+			config.Sources = []Source{{FS: files}}
+
+			if err := gen.generate(config); err != nil {
+				t.Fatalf("Generate returned unexpected error %v", err)
+			}
+
+			for _, f := range gen.Files {
+				if f.Size != test.expect[f.name] {
+					t.Errorf("Did not get expected size for %s got (%d) expected (%d)", f.name, f.Size, test.expect[f.name])
+				}
+			}
+		})
+	}
+}
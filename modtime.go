@@ -0,0 +1,140 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SourceDateEpoch is the environment variable holding the Unix timestamp
+	// modification times are clamped to, see https://reproducible-builds.org/specs/source-date-epoch/
+	SourceDateEpoch = "SOURCE_DATE_EPOCH"
+)
+
+// parseTime parses a Unix timestamp or a RFC 3339 time
+func parseTime(value string) (t int64, err error) {
+	var e error
+
+	if t, e = strconv.ParseInt(value, 10, 64); e != nil {
+		var tm time.Time
+
+		if tm, err = time.Parse(time.RFC3339, value); err == nil {
+			t = tm.Unix()
+		} else {
+			err = fmt.Errorf("must be a Unix timestamp or RFC 3339 time: %v", e)
+		}
+	}
+
+	return
+}
+
+// sourceDateEpoch returns the value of SOURCE_DATE_EPOCH, nil if not set
+func sourceDateEpoch() (epoch *int64, err error) {
+	if value := os.Getenv(SourceDateEpoch); len(value) > 0 {
+		var i int64
+
+		if i, err = strconv.ParseInt(value, 10, 64); err == nil {
+			epoch = &i
+		} else {
+			err = fmt.Errorf("%s must be an integer: %v", SourceDateEpoch, err)
+		}
+	}
+
+	return
+}
+
+// gitTimes holds the last commit time of files in git working trees
+type gitTimes struct {
+	times   map[string]int64
+	scanned map[string]bool
+}
+
+func newGitTimes() *gitTimes {
+	return &gitTimes{
+		times:   make(map[string]int64),
+		scanned: make(map[string]bool),
+	}
+}
+
+// load reads the commit times of all files under local
+func (g *gitTimes) load(local string) (err error) {
+	var (
+		dir  string
+		fi   os.FileInfo
+		out  []byte
+		spec = "."
+	)
+
+	if dir, err = filepath.Abs(local); err == nil {
+		fi, err = os.Stat(dir)
+	}
+
+	if err == nil && !fi.IsDir() {
+		spec = filepath.Base(dir)
+		dir = filepath.Dir(dir)
+	}
+
+	if err != nil || g.scanned[filepath.Join(dir, spec)] {
+		return
+	}
+
+	g.scanned[filepath.Join(dir, spec)] = true
+
+	// The log is newest first, the first time seen for a file is its last commit
+	cmd := exec.Command("git", "-c", "core.quotePath=false", "log",
+		"--format=%x00%ct", "--name-only", "--no-renames", "--relative", "--", spec)
+	cmd.Dir = dir
+
+	if out, err = cmd.Output(); err != nil {
+		if e, ok := err.(*exec.ExitError); ok && len(e.Stderr) > 0 {
+			err = fmt.Errorf("%s: git log failed: %s", local, strings.TrimSpace(string(e.Stderr)))
+		} else {
+			err = fmt.Errorf("%s: git log failed: %v", local, err)
+		}
+		return
+	}
+
+	var commit int64
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(line, "\x00"):
+			commit, err = strconv.ParseInt(line[1:], 10, 64)
+		case len(line) > 0:
+			name := filepath.Join(dir, filepath.FromSlash(line))
+			if _, ok := g.times[name]; !ok {
+				g.times[name] = commit
+			}
+		}
+	}
+
+	if err == nil {
+		err = scanner.Err()
+	}
+
+	return
+}
+
+// lookup returns the last commit time of the local file
+func (g *gitTimes) lookup(local string) (t int64, ok bool) {
+	if g != nil && len(local) > 0 {
+		if name, err := filepath.Abs(local); err == nil {
+			t, ok = g.times[name]
+		}
+	}
+	return
+}
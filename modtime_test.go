@@ -0,0 +1,177 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		value  string
+		expect int64
+		hasErr bool
+	}{
+		{"Unix", "1579282495", 1579282495, false},
+		{"RFC 3339", "2020-01-17T17:34:55Z", 1579282495, false},
+		{"RFC 3339 Zone", "2020-01-17T09:34:55-08:00", 1579282495, false},
+		{"Bad", "yesterday", 0, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			m, err := parseTime(test.value)
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("parseTime did not return an error")
+				} else if m != test.expect {
+					t.Errorf("Did not get expected got (%d) expected (%d)", m, test.expect)
+				}
+			} else if !test.hasErr {
+				t.Errorf("parseTime returned unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestModTime(t *testing.T) {
+	base, err := createFs()
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	old := time.Unix(1579282495, 0)
+	os.Chtimes(filepath.Join(base, "www", "index.html"), old, old)
+
+	defer os.Setenv(SourceDateEpoch, os.Getenv(SourceDateEpoch))
+
+	for _, test := range []struct {
+		name   string
+		epoch  string
+		modify string
+		git    bool
+		setup  func() error
+		expect map[string]int64
+		hasErr bool
+	}{
+		{
+			name:   "Bad Epoch",
+			epoch:  "yesterday",
+			hasErr: true,
+		},
+		{
+			name:  "Epoch",
+			epoch: "1579282500",
+			expect: map[string]int64{
+				"/":                1579282500,
+				"/index.html":      1579282495,
+				"/scripts/init.js": 1579282500,
+			},
+		},
+		{
+			name:   "Modify Time",
+			epoch:  "1579282500",
+			modify: "2020-01-17T17:34:00Z",
+			expect: map[string]int64{
+				"/":                1579282440,
+				"/index.html":      1579282440,
+				"/scripts/init.js": 1579282440,
+			},
+		},
+		{
+			name:   "Not Git",
+			git:    true,
+			hasErr: true,
+		},
+		{
+			name:  "Git",
+			epoch: "1579282500",
+			git:   true,
+			setup: func() (err error) {
+				for _, step := range []struct {
+					date string
+					args []string
+				}{
+					{"", []string{"init", "-q"}},
+					{"", []string{"add", "www/index.html"}},
+					{"1500000000 +0000", []string{"commit", "-q", "-m", "index"}},
+					{"", []string{"add", "www/scripts"}},
+					{"1500000100 +0000", []string{"commit", "-q", "-m", "scripts"}},
+				} {
+					if err == nil {
+						cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, step.args...)...)
+						cmd.Dir = base
+						cmd.Env = append(os.Environ(), "GIT_COMMITTER_DATE="+step.date, "GIT_AUTHOR_DATE="+step.date)
+						err = cmd.Run()
+					}
+				}
+				return
+			},
+			expect: map[string]int64{
+				"/":                1579282500,
+				"/index.html":      1500000000,
+				"/scripts":         1500000100,
+				"/scripts/init.js": 1500000100,
+				"/code":            1579282500,
+				"/code/process.go": 1579282500,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var gen generate
+
+			if test.setup != nil {
+				if _, err := exec.LookPath("git"); err != nil {
+					t.Skip("git not installed")
+				}
+
+				if err := test.setup(); err != nil {
+					t.Fatalf("unable to setup %v", err)
+				}
+			}
+
+			os.Setenv(SourceDateEpoch, test.epoch)
+
+			config := New()
+			config.Output = filepath.Join(base, "assets", "files")
+			config.Files = []string{filepath.Join(base, "www")}
+			config.ModifyTime = test.modify
+			config.GitModifyTime = test.git
+
+			err := gen.generate(config)
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("Generate did not return an error")
+				}
+			} else if !test.hasErr {
+				t.Errorf("Generate returned unexpected error %v", err)
+			}
+
+			times := make(map[string]int64)
+			for _, f := range gen.Files {
+				times[f.name] = f.ModTime
+			}
+			for _, d := range gen.Dirs {
+				times[d.name] = d.ModTime
+			}
+
+			for name, expect := range test.expect {
+				if m := times[name]; m != expect {
+					t.Errorf("Did not get expected time for %s got (%d) expected (%d)", name, m, expect)
+				}
+			}
+		})
+	}
+}
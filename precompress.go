@@ -0,0 +1,131 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// precompressedExts maps the extension of precompressed siblings to their content coding
+var precompressedExts = map[string]string{
+	".gz":  "gzip",
+	".br":  "br",
+	".zst": "zstd",
+}
+
+// sibling a precompressed copy of a file, such as app.js.gz next to app.js
+type sibling struct {
+	encoding string
+	fsys     fs.FS
+	path     string
+}
+
+// precompressed attaches files with a precompressed extension to the file
+// they were compressed from, they are no longer embedded as files of their own.
+func (gen *generate) precompressed() {
+	names := make(map[string]*file, len(gen.Files))
+	for _, f := range gen.Files {
+		names[f.name] = f
+	}
+
+	files := gen.Files[:0]
+
+	for _, f := range gen.Files {
+		ext := path.Ext(f.name)
+		encoding := precompressedExts[strings.ToLower(ext)]
+
+		if base := names[strings.TrimSuffix(f.name, ext)]; len(encoding) > 0 && base != nil {
+			base.siblings = append(base.siblings, &sibling{encoding: encoding, fsys: f.fsys, path: f.path})
+			delete(gen.processed, f.name)
+		} else {
+			files = append(files, f)
+		}
+	}
+
+	if len(files) < len(gen.Files) {
+		gen.Files = files
+
+		for _, f := range gen.Files {
+			sort.Slice(f.siblings, func(i, j int) bool {
+				return f.siblings[i].encoding < f.siblings[j].encoding
+			})
+		}
+
+		gen.reparent()
+	}
+}
+
+// precompressed uses the siblings of the file as its encoded contents, a gzip
+// sibling is stored as the compressed contents once checked it matches data.
+// Other siblings can not be checked, they are only used when not older than
+// the file so a sibling left from an earlier build is not served.
+func (f *file) precompressed(data []byte) (b []byte, err error) {
+	var info fs.FileInfo
+
+	b = data
+
+	if info, err = fs.Stat(f.fsys, f.path); err != nil {
+		return
+	}
+
+	modTime := info.ModTime().Truncate(time.Second)
+
+	for _, s := range f.siblings {
+		var contents []byte
+
+		if s.encoding != "gzip" {
+			if info, err = fs.Stat(s.fsys, s.path); err != nil {
+				break
+			}
+
+			if info.ModTime().Truncate(time.Second).Before(modTime) {
+				f.logf("warning: %s: precompressed %s is older than the file, not used", f.name, s.path)
+				continue
+			}
+		}
+
+		if contents, err = fs.ReadFile(s.fsys, s.path); err != nil {
+			break
+		}
+
+		if s.encoding == "gzip" {
+			if err = checkGzip(contents, data); err != nil {
+				err = fmt.Errorf("%s: precompressed %s: %v", f.name, s.path, err)
+				break
+			}
+
+			b = contents
+			f.dataSize = len(b)
+			f.Compressed = true
+		} else {
+			f.encodings = append(f.encodings, &encoded{file: f, encoding: s.encoding, data: contents})
+		}
+	}
+
+	return
+}
+
+// checkGzip returns an error unless compressed decompresses to data
+func checkGzip(compressed []byte, data []byte) error {
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+
+	if err == nil {
+		var b []byte
+
+		if b, err = ioutil.ReadAll(r); err == nil && !bytes.Equal(b, data) {
+			err = fmt.Errorf("does not match the file contents")
+		}
+	}
+
+	return err
+}
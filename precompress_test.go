@@ -0,0 +1,229 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestPrecompressed(t *testing.T) {
+	base, err := ioutil.TempDir("", "precompressed-test")
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	script := []byte(strings.Repeat("function  add ( a, b ) {\n    return a + b;\n}\n", 20))
+	gz, _ := gzipBytes(script)
+
+	files := fstest.MapFS{
+		"app.js":         &fstest.MapFile{Data: script},
+		"app.js.gz":      &fstest.MapFile{Data: gz},
+		"app.js.br":      &fstest.MapFile{Data: []byte("brotli")},
+		"archive.tar.gz": &fstest.MapFile{Data: gz},
+	}
+
+	stale := fstest.MapFS{
+		"app.js":    &fstest.MapFile{Data: []byte("function add(a,b){return a+b}")},
+		"app.js.gz": &fstest.MapFile{Data: gz},
+	}
+
+	// The bundler wrote app.js after app.js.br
+	oldBrotli := fstest.MapFS{
+		"app.js":    &fstest.MapFile{Data: script, ModTime: time.Unix(1600000000, 0)},
+		"app.js.gz": &fstest.MapFile{Data: gz, ModTime: time.Unix(1600000000, 0)},
+		"app.js.br": &fstest.MapFile{Data: []byte("brotli"), ModTime: time.Unix(1500000000, 0)},
+	}
+
+	// Fingerprinting rewrites the reference in index.html
+	page := []byte(strings.Repeat(`<script src="/assets/app.js"></script>`, 10))
+	pageGz, _ := gzipBytes(page)
+	sum := sha1.Sum(script)
+
+	fingerprinted := fstest.MapFS{
+		"index.html":       &fstest.MapFile{Data: page},
+		"index.html.gz":    &fstest.MapFile{Data: pageGz},
+		"index.html.br":    &fstest.MapFile{Data: []byte("brotli")},
+		"assets/app.js":    &fstest.MapFile{Data: script},
+		"assets/app.js.gz": &fstest.MapFile{Data: gz},
+		"assets/app.js.br": &fstest.MapFile{Data: []byte("brotli")},
+	}
+
+	header := TransformerFunc(func(name, mimeType string, data []byte) (*Transformed, error) {
+		return &Transformed{Data: append([]byte("/* "+name+" */"), data...)}, nil
+	})
+
+	rename := TransformerFunc(func(name, mimeType string, data []byte) (*Transformed, error) {
+		return &Transformed{Data: data, Name: "/main.js"}, nil
+	})
+
+	for _, test := range []struct {
+		name          string
+		files         fstest.MapFS
+		precompressed bool
+		compress      bool
+		encoders      []Encoder
+		transforms    []Transform
+		fingerprint   string
+		expect        map[string][]string
+		warnings      int
+		hasErr        bool
+	}{
+		{
+			name:          "Siblings",
+			files:         files,
+			precompressed: true,
+			compress:      true,
+			expect:        map[string][]string{"/app.js": {"gzip", "br"}, "/archive.tar.gz": {}},
+		},
+		{
+			name:          "Not Compressed",
+			files:         files,
+			precompressed: true,
+			expect:        map[string][]string{"/app.js": {"gzip", "br"}, "/archive.tar.gz": {}},
+		},
+		{
+			name:          "Encoders",
+			files:         files,
+			precompressed: true,
+			compress:      true,
+			encoders:      []Encoder{&testEncoder{name: "br"}, &testEncoder{name: "deflate"}},
+			expect:        map[string][]string{"/app.js": {"gzip", "br", "deflate"}, "/archive.tar.gz": {}},
+		},
+		{
+			name:  "Disabled",
+			files: files,
+			expect: map[string][]string{
+				"/app.js":         {},
+				"/app.js.br":      {},
+				"/app.js.gz":      {},
+				"/archive.tar.gz": {},
+			},
+		},
+		{
+			name:          "Older Brotli",
+			files:         oldBrotli,
+			precompressed: true,
+			expect:        map[string][]string{"/app.js": {"gzip"}},
+			warnings:      1,
+		},
+		{
+			name:          "Fingerprint",
+			files:         fingerprinted,
+			precompressed: true,
+			fingerprint:   "^/assets/",
+			expect: map[string][]string{
+				"/index.html": {},
+				"/assets/app." + hex.EncodeToString(sum[:])[:fingerprintSize] + ".js": {"gzip", "br"},
+			},
+			warnings: 1,
+		},
+		{
+			name:          "Transform",
+			files:         files,
+			precompressed: true,
+			compress:      true,
+			transforms:    []Transform{{"*.js", header}},
+			expect:        map[string][]string{"/app.js": {"gzip"}, "/archive.tar.gz": {}},
+			warnings:      1,
+		},
+		{
+			name:          "Rename",
+			files:         files,
+			precompressed: true,
+			transforms:    []Transform{{"app.js", rename}},
+			expect:        map[string][]string{"/main.js": {"gzip", "br"}, "/archive.tar.gz": {}},
+		},
+		{
+			name:          "Stale",
+			files:         stale,
+			precompressed: true,
+			hasErr:        true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				gen      generate
+				warnings []string
+			)
+
+			config := New()
+			config.Output = filepath.Join(base, "assets", "files")
+			config.Logf = func(format string, v ...interface{}) {
+				if msg := fmt.Sprintf(format, v...); strings.HasPrefix(msg, "warning:") {
+					warnings = append(warnings, msg)
+				}
+			}
+			config.Fingerprint = test.fingerprint
+			config.Precompressed = test.precompressed
+			config.Encoders = test.encoders
+			config.Transforms = test.transforms
+			config.DisableCompression = !test.compress
+			config.Sources = []Source{{FS: test.files}}
+
+			err := gen.generate(config)
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("Generate did not return an error")
+				}
+			} else if !test.hasErr {
+				t.Fatalf("Generate returned unexpected error %v", err)
+			}
+
+			if err == nil {
+				encodings := make(map[string][]string)
+				for _, f := range gen.Files {
+					encodings[f.name] = []string{}
+					if f.Compressed {
+						encodings[f.name] = append(encodings[f.name], "gzip")
+					}
+					for _, e := range f.encodings {
+						encodings[f.name] = append(encodings[f.name], e.encoding)
+					}
+				}
+
+				if !reflect.DeepEqual(encodings, test.expect) {
+					t.Errorf("Did not get expected encodings got (%v) expected (%v)", encodings, test.expect)
+				}
+
+				if len(warnings) != test.warnings {
+					t.Errorf("Did not get expected warnings got (%v) expected %d", warnings, test.warnings)
+				}
+
+				var names []string
+				for _, d := range gen.Dirs {
+					if d.name == "/" {
+						names = d.Files()
+					}
+				}
+
+				if len(names) != len(test.expect) {
+					t.Errorf("Did not get expected directory entries got (%v)", names)
+				}
+
+				for _, f := range gen.Files {
+					if f.name == "/app.js" && test.precompressed && test.transforms == nil && f.Size != len(script) {
+						t.Errorf("Did not get expected size got (%d) expected (%d)", f.Size, len(script))
+					}
+				}
+			}
+		})
+	}
+}
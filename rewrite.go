@@ -0,0 +1,223 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// ConflictError fails generation when two files are stored with the same name
+	ConflictError = "error"
+	// ConflictFirst keeps the first file stored with a name, later ones are left out
+	ConflictFirst = "first"
+	// ConflictLast keeps the last file stored with a name, replacing earlier ones
+	ConflictLast = "last"
+)
+
+// Rewrite is a rule changing the names files are stored as.
+type Rewrite struct {
+	// Pattern is the regexp matched against the embedded name (for example `^/dist/app/(.*)$`).
+	Pattern string `json:"pattern"`
+	// Replacement replaces the matches, $1 and ${name} are expanded (for example `/static/$1`).
+	Replacement string `json:"replacement"`
+}
+
+// rewriteRule a compiled Rewrite
+type rewriteRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// parseRewrites compiles the rewrite rules
+func parseRewrites(list []Rewrite) (rules []rewriteRule, err error) {
+	for _, entry := range list {
+		var re *regexp.Regexp
+
+		if re, err = regexp.Compile(entry.Pattern); err != nil {
+			return nil, fmt.Errorf("Rewrite %q: %v", entry.Pattern, err)
+		}
+
+		rules = append(rules, rewriteRule{pattern: re, replacement: entry.Replacement})
+	}
+
+	return
+}
+
+// parseConflict checks the conflict policy, empty is ConflictError
+func parseConflict(policy string) (string, error) {
+	switch policy {
+	case "":
+		return ConflictError, nil
+	case ConflictError, ConflictFirst, ConflictLast:
+		return policy, nil
+	}
+
+	return "", fmt.Errorf("Conflict %q is not one of %s, %s or %s", policy, ConflictError, ConflictFirst, ConflictLast)
+}
+
+// rewriting returns true if names are rewritten
+func (gen *generate) rewriting() bool {
+	return len(gen.rewrites) > 0 || gen.config.RewriteFunc != nil
+}
+
+// rename applies the rewrite rules, in order, and then the RewriteFunc to name
+func (gen *generate) rename(name string) string {
+	if !gen.rewriting() {
+		return name
+	}
+
+	for _, rule := range gen.rewrites {
+		name = rule.pattern.ReplaceAllString(name, rule.replacement)
+	}
+
+	if gen.config.RewriteFunc != nil {
+		name = gen.config.RewriteFunc(name)
+	}
+
+	return path.Clean("/" + name)
+}
+
+// claim records the name of a file or link, returning skip if an earlier entry
+// with the name is kept. Names used by directories are always an error.
+func (gen *generate) claim(name string, fpath string) (skip bool, err error) {
+	if !gen.processed[name] || gen.dirNames[name] != nil {
+		return false, gen.checkProcessed(name, fpath)
+	}
+
+	switch gen.conflict {
+	case ConflictFirst:
+		skip = true
+	case ConflictLast:
+		gen.remove(name)
+	default:
+		err = gen.checkProcessed(name, fpath)
+	}
+
+	return
+}
+
+// reclaim takes the renamed files out of Files and claims their new names in
+// turn, as scan does, returning the files left out by the conflict policy
+func (gen *generate) reclaim(renamed []*file) (dropped map[*file]bool, err error) {
+	taken := make(map[*file]bool, len(renamed))
+	for _, f := range renamed {
+		taken[f] = true
+	}
+
+	files := make([]*file, 0, len(gen.Files))
+	for _, f := range gen.Files {
+		if !taken[f] {
+			files = append(files, f)
+		}
+	}
+	gen.Files = files
+
+	dropped = make(map[*file]bool)
+
+	for _, f := range renamed {
+		var skip bool
+
+		if err == nil {
+			if skip, err = gen.claim(f.name, f.path); err == nil && skip {
+				dropped[f] = true
+			} else if err == nil {
+				gen.Files = append(gen.Files, f)
+			}
+		}
+	}
+
+	return
+}
+
+// remove removes the file or link name
+func (gen *generate) remove(name string) {
+	for i, f := range gen.Files {
+		if f.name == name {
+			gen.Files = append(gen.Files[:i], gen.Files[i+1:]...)
+			return
+		}
+	}
+
+	for i, l := range gen.Links {
+		if l.name == name {
+			gen.Links = append(gen.Links[:i], gen.Links[i+1:]...)
+			return
+		}
+	}
+}
+
+// reparent rebuilds the entries of directories once names are rewritten, adding
+// the parent directories new names need and leaving out directories the rewrite emptied
+func (gen *generate) reparent() {
+	// Directories with entries before the rewrite, or only added for one, are left out once empty
+	droppable := make(map[*dir]bool, len(gen.Dirs))
+
+	for _, d := range gen.Dirs {
+		droppable[d] = len(d.files) > 0
+		d.files = make(map[string]bool)
+	}
+
+	var add func(name string)
+
+	add = func(name string) {
+		if name != "/" {
+			parent := path.Dir(name)
+			d := gen.dirNames[parent]
+
+			if d == nil {
+				d = &dir{
+					name:     parent,
+					baseName: path.Base(parent),
+					ModTime:  gen.last,
+					files:    make(map[string]bool),
+				}
+				droppable[d] = true
+				gen.dirNames[parent] = d
+				gen.Dirs = append(gen.Dirs, d)
+				add(parent)
+			}
+
+			d.files[name] = true
+		}
+	}
+
+	for _, f := range gen.Files {
+		add(f.name)
+	}
+
+	for _, l := range gen.Links {
+		add(l.name)
+	}
+
+	for _, d := range append([]*dir(nil), gen.Dirs...) {
+		add(d.name)
+	}
+
+	// Deepest first so a parent emptied in turn is also left out
+	dirs := append([]*dir(nil), gen.Dirs...)
+	sort.Slice(dirs, func(i, j int) bool {
+		return strings.Count(dirs[i].name, "/") > strings.Count(dirs[j].name, "/")
+	})
+
+	for _, d := range dirs {
+		if droppable[d] && len(d.files) == 0 && d.name != "/" {
+			delete(gen.dirNames, d.name)
+			delete(gen.processed, d.name)
+			delete(gen.dirNames[path.Dir(d.name)].files, d.name)
+		}
+	}
+
+	gen.Dirs = gen.Dirs[:0]
+	for _, d := range dirs {
+		if gen.dirNames[d.name] == d {
+			gen.Dirs = append(gen.Dirs, d)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestRewrite(t *testing.T) {
+	base, err := ioutil.TempDir("", "rewrite-test")
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	files := fstest.MapFS{
+		"index.html":         &fstest.MapFile{Data: []byte("index")},
+		"dist/app/App.js":    &fstest.MapFile{Data: []byte("app")},
+		"dist/app/css/a.css": &fstest.MapFile{Data: []byte("css")},
+		"dist/README.md":     &fstest.MapFile{Data: []byte("readme")},
+	}
+
+	other := fstest.MapFS{
+		"index.html": &fstest.MapFile{Data: []byte("other")},
+	}
+
+	for _, test := range []struct {
+		name     string
+		rewrite  []Rewrite
+		fn       func(string) string
+		conflict string
+		sources  []Source
+		expect   map[string]string
+		dirs     map[string][]string
+		hasErr   bool
+	}{
+		{
+			name:    "Rules",
+			rewrite: []Rewrite{{`^/dist/app/(.*)$`, "/static/$1"}},
+			sources: []Source{{FS: files}},
+			expect: map[string]string{
+				"/index.html":       "index",
+				"/static/App.js":    "app",
+				"/static/css/a.css": "css",
+				"/dist/README.md":   "readme",
+			},
+			dirs: map[string][]string{
+				"/":           {"/dist", "/index.html", "/static"},
+				"/dist":       {"/dist/README.md"},
+				"/static":     {"/static/App.js", "/static/css"},
+				"/static/css": {"/static/css/a.css"},
+			},
+		},
+		{
+			name:    "Func",
+			rewrite: []Rewrite{{`^/dist/app/(.*)$`, "/static/$1"}},
+			fn:      strings.ToLower,
+			sources: []Source{{FS: files, Path: "dist/app", Mount: "/dist/app"}},
+			expect: map[string]string{
+				"/static/app.js":    "app",
+				"/static/css/a.css": "css",
+			},
+			dirs: map[string][]string{
+				"/":           {"/static"},
+				"/static":     {"/static/app.js", "/static/css"},
+				"/static/css": {"/static/css/a.css"},
+			},
+		},
+		{
+			name:    "Error",
+			sources: []Source{{FS: files}, {FS: other}},
+			hasErr:  true,
+		},
+		{
+			name:    "Shared Directory",
+			sources: []Source{{FS: files, Path: "dist"}, {FS: other}},
+			hasErr:  true,
+		},
+		{
+			name:     "Merge",
+			conflict: ConflictLast,
+			sources:  []Source{{FS: files}, {FS: other}},
+			expect: map[string]string{
+				"/index.html":         "other",
+				"/dist/app/App.js":    "app",
+				"/dist/app/css/a.css": "css",
+				"/dist/README.md":     "readme",
+			},
+			dirs: map[string][]string{
+				"/":             {"/dist", "/index.html"},
+				"/dist":         {"/dist/README.md", "/dist/app"},
+				"/dist/app":     {"/dist/app/App.js", "/dist/app/css"},
+				"/dist/app/css": {"/dist/app/css/a.css"},
+			},
+		},
+		{
+			name:     "First",
+			conflict: ConflictFirst,
+			sources:  []Source{{FS: files, Path: "<->index.html"}, {FS: other, Path: "<->index.html"}},
+			expect:   map[string]string{"/index.html": "index"},
+		},
+		{
+			name:     "Last",
+			conflict: ConflictLast,
+			sources:  []Source{{FS: files, Path: "<->index.html"}, {FS: other, Path: "<->index.html"}},
+			expect:   map[string]string{"/index.html": "other"},
+		},
+		{
+			name:     "Folder Name",
+			conflict: ConflictLast,
+			rewrite:  []Rewrite{{`^/index.html$`, "/dist"}},
+			sources:  []Source{{FS: files}},
+			hasErr:   true,
+		},
+		{
+			name:    "Bad Rule",
+			rewrite: []Rewrite{{`(`, ""}},
+			sources: []Source{{FS: files}},
+			hasErr:  true,
+		},
+		{
+			name:     "Bad Conflict",
+			conflict: "merge",
+			sources:  []Source{{FS: files}},
+			hasErr:   true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var gen generate
+
+			config := New()
+			config.Output = filepath.Join(base, "assets", "files")
+			config.Rewrite = test.rewrite
+			config.RewriteFunc = test.fn
+			config.Conflict = test.conflict
+			config.DisableCompression = true
+			config.Minify = ""
+			config.Sources = test.sources
+
+			err := gen.generate(config)
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("Generate did not return an error")
+				}
+			} else if !test.hasErr {
+				t.Fatalf("Generate returned unexpected error %v", err)
+			}
+
+			if err == nil {
+				contents := make(map[string]string)
+				for _, f := range gen.Files {
+					b, _ := fs.ReadFile(f.fsys, f.path)
+					contents[f.name] = string(b)
+				}
+
+				if !reflect.DeepEqual(contents, test.expect) {
+					t.Errorf("Did not get expected files got (%v) expected (%v)", contents, test.expect)
+				}
+
+				if test.dirs != nil {
+					dirs := make(map[string][]string)
+					for _, d := range gen.Dirs {
+						var list []string
+						for name := range d.files {
+							list = append(list, name)
+						}
+						sort.Strings(list)
+						dirs[d.name] = list
+					}
+
+					if !reflect.DeepEqual(dirs, test.dirs) {
+						t.Errorf("Did not get expected dirs got (%v) expected (%v)", dirs, test.dirs)
+					}
+				}
+			}
+		})
+	}
+}
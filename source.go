@@ -0,0 +1,240 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Source is a file or directory to embed along with rules that only apply to it.
+type Source struct {
+	// FS is the file system to read from, if nil Path is read from the local file system.
+	FS fs.FS `json:"-"`
+	// Path is the file or directory to embed, it may contain a PrefixMarker.
+	// Path may also be a glob, such as web/dist/**/*.{js,css}, where ** matches
+	// any number of directories, the files matching are stored relative to the
+	// directory before the first pattern or the PrefixMarker.
+	// When FS is set Path is a slash separated path within FS, if empty the
+	// whole of FS is embedded.
+	Path string `json:"path"`
+	// Mount is the name the source is stored as, the entries of a directory
+	// are stored under it, if set the PrefixMarker is ignored.
+	Mount string `json:"mount"`
+	// Include is a list of glob patterns for files to include. If provided,
+	// only files that match will be included.
+	Include []string `json:"include"`
+	// Ignore is a list of glob patterns for files and directories we should
+	// ignore, an ignored directory is not scanned.
+	Ignore []string `json:"ignore"`
+	// Minify, if set, overrides Config.Minify for the source. If true all files
+	// with a supported mime type are minified.
+	Minify *bool `json:"minify"`
+	// Compress, if set, overrides Config.DisableCompression for the source.
+	Compress *bool `json:"compress"`
+}
+
+// source is a Source ready to scan
+type source struct {
+	fsys     fs.FS
+	root     string // path of the source within fsys
+	mount    string // name the root is stored as
+	local    string // local file system path of the root, empty if not on disk
+	include  []string
+	ignore   []string
+	minify   map[string]bool
+	compress bool
+	glob     [][]string      // compiled pattern files must match, nil for all files
+	dirs     []scanDir       // directories being scanned
+	links    int             // directory links followed to the directory being scanned
+	ignores  []ignorePattern // patterns of the ignore files of the directories being scanned
+}
+
+// newSource resolves the mount point and file system for a Source.
+func newSource(s Source) (src *source, err error) {
+	var (
+		prefix string
+		fi     fs.FileInfo
+	)
+
+	fpath := s.Path
+	marker := strings.Index(fpath, PrefixMarker)
+	if marker >= 0 {
+		prefix = fpath[:marker]
+		fpath = strings.Replace(fpath, PrefixMarker, "", 1)
+	}
+
+	// A glob is scanned from the directory before the first pattern, unless
+	// a file has the name
+	base, pattern := splitGlob(filepath.ToSlash(fpath))
+	if len(pattern) > 0 && exists(s.FS, fpath) {
+		pattern = ""
+	}
+
+	if len(pattern) > 0 {
+		if len(filepath.ToSlash(prefix)) > len(base) {
+			return nil, fmt.Errorf("%s: prefix marker must be before the pattern", s.Path)
+		}
+
+		fpath = base
+		if s.FS == nil {
+			fpath = filepath.FromSlash(base)
+		}
+	}
+
+	if s.FS == nil {
+		if fi, err = os.Stat(fpath); err == nil {
+			src = &source{local: fpath}
+
+			if fi.IsDir() {
+				src.fsys = os.DirFS(fpath)
+				src.root = "."
+			} else {
+				src.fsys = os.DirFS(filepath.Dir(fpath))
+				src.root = filepath.Base(fpath)
+			}
+		}
+		fpath = filepath.ToSlash(fpath)
+		prefix = filepath.ToSlash(prefix)
+	} else {
+		root := path.Clean("/" + fpath)[1:]
+		if root == "" {
+			root = "."
+		}
+
+		if fs.ValidPath(root) {
+			src = &source{fsys: s.FS, root: root}
+			fi, err = fs.Stat(s.FS, root)
+		} else {
+			err = &fs.PathError{Op: "open", Path: s.Path, Err: fs.ErrInvalid}
+		}
+	}
+
+	if err == nil {
+		if marker < 0 || len(s.Mount) > 0 {
+			// The path is stored as the root, a file too
+			prefix = fpath
+		}
+
+		src.mount = path.Join("/", s.Mount, strings.TrimPrefix(fpath, prefix))
+		src.include = s.Include
+		src.ignore = s.Ignore
+
+		if len(pattern) > 0 {
+			if !fi.IsDir() {
+				err = fmt.Errorf("%s: %s is not a directory", s.Path, fpath)
+			} else if src.glob, err = compileGlob(pattern); err != nil {
+				err = fmt.Errorf("%s: bad pattern %q: %v", s.Path, pattern, err)
+			}
+		}
+
+		for _, pattern := range append(s.Include, s.Ignore...) {
+			if err != nil {
+				break
+			}
+			if _, err = path.Match(pattern, ""); err != nil {
+				err = fmt.Errorf("%s: bad pattern %q: %v", s.Path, pattern, err)
+			}
+		}
+	}
+
+	return
+}
+
+// exists returns true if fpath is in fsys, or the local file system if fsys is nil
+func exists(fsys fs.FS, fpath string) bool {
+	var err error
+
+	if fsys == nil {
+		_, err = os.Stat(fpath)
+	} else {
+		_, err = fs.Stat(fsys, strings.TrimPrefix(path.Clean("/"+fpath), "/"))
+	}
+
+	return err == nil
+}
+
+// skip returns true if the entry rel relative to the root is excluded by the rules of the source
+func (s *source) skip(rel string) bool {
+	if rel == "" {
+		rel = path.Base(s.root)
+	}
+
+	if s.glob != nil && !matchGlob(s.glob, rel) {
+		return true
+	}
+
+	if len(s.include) > 0 && !match(s.include, rel) {
+		return true
+	}
+
+	return match(s.ignore, rel)
+}
+
+// match returns true if name matches one of the glob patterns,
+// patterns without a slash are matched against the base name.
+func match(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		n := name
+		if !strings.Contains(pattern, "/") {
+			n = path.Base(name)
+		}
+
+		if ok, _ := path.Match(pattern, n); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// name returns the path within fsys of the entry rel relative to the root
+func (s *source) name(rel string) string {
+	return path.Join(s.root, rel)
+}
+
+// canonicalName returns the name the entry rel relative to the root is stored as
+func (s *source) canonicalName(rel string) string {
+	return path.Join(s.mount, rel)
+}
+
+// localPath returns the local file system path of the entry rel relative to the root
+func (s *source) localPath(rel string) (local string) {
+	if len(s.local) > 0 {
+		local = filepath.Join(s.local, filepath.FromSlash(rel))
+	}
+	return
+}
+
+// path returns the path of the entry rel relative to the root used for matching and errors
+func (s *source) path(rel string) string {
+	if len(s.local) > 0 {
+		return s.localPath(rel)
+	}
+	return s.name(rel)
+}
+
+// dirPath returns the path of the directory rel relative to the root with a trailing separator
+func (s *source) dirPath(rel string) string {
+	if len(s.local) > 0 {
+		return s.localPath(rel) + string(filepath.Separator)
+	}
+	return s.name(rel) + "/"
+}
+
+// sources returns all the sources, Files followed by Sources
+func (config *Config) sources() []Source {
+	list := make([]Source, 0, len(config.Files)+len(config.Sources))
+
+	for _, entry := range config.Files {
+		list = append(list, Source{Path: entry})
+	}
+
+	return append(list, config.Sources...)
+}
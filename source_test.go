@@ -0,0 +1,110 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSource(t *testing.T) {
+	base, err := createFs()
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	mapFS := fstest.MapFS{
+		"static/css/main.css": &fstest.MapFile{Data: []byte("body {}")},
+		"static/index.html":   &fstest.MapFile{Data: []byte("<html></html>")},
+	}
+
+	for _, test := range []struct {
+		name     string
+		source   Source
+		hasError bool
+		root     string
+		mount    string
+		local    string
+	}{
+		{"Folder", Source{Path: filepath.Join(base, "www")}, false, ".", "/", filepath.Join(base, "www")},
+		{"Folder Prefix", Source{Path: base + PrefixMarker + "/www"}, false, ".", "/www", filepath.Join(base, "www")},
+		{"File", Source{Path: filepath.Join(base, "single", "settings.html")}, false, "settings.html", "/", filepath.Join(base, "single", "settings.html")},
+		{"Mount", Source{Path: base + PrefixMarker + "/www", Mount: "/static"}, false, ".", "/static", filepath.Join(base, "www")},
+		{"Mount File", Source{Path: filepath.Join(base, "single", "settings.html"), Mount: "static"}, false, "settings.html", "/static", filepath.Join(base, "single", "settings.html")},
+		{"Bad Pattern", Source{Path: filepath.Join(base, "www"), Include: []string{"[]"}}, true, "", "", ""},
+		{"Missing", Source{Path: filepath.Join(base, "missing")}, true, "", "", ""},
+		{"FS", Source{FS: mapFS}, false, ".", "/", ""},
+		{"FS Folder", Source{FS: mapFS, Path: "static"}, false, "static", "/", ""},
+		{"FS Prefix", Source{FS: mapFS, Path: "static<->/css"}, false, "static/css", "/css", ""},
+		{"FS File", Source{FS: mapFS, Path: "static/index.html"}, false, "static/index.html", "/", ""},
+		{"FS File Prefix", Source{FS: mapFS, Path: "static<->/index.html"}, false, "static/index.html", "/index.html", ""},
+		{"FS Missing", Source{FS: mapFS, Path: "missing"}, true, "", "", ""},
+		{"Glob", Source{Path: filepath.Join(base, "www") + "/**/*.html"}, false, ".", "/", filepath.Join(base, "www")},
+		{"Glob Prefix", Source{Path: base + PrefixMarker + "/www/**/*.js"}, false, ".", "/www", filepath.Join(base, "www")},
+		{"FS Glob", Source{FS: mapFS, Path: "static/**/*.{css,html}"}, false, "static", "/", ""},
+		{"FS Glob Prefix", Source{FS: mapFS, Path: "static<->/css/*.css"}, false, "static/css", "/css", ""},
+		{"Glob Marker After", Source{FS: mapFS, Path: "static/*<->/main.css"}, true, "", "", ""},
+		{"Glob Bad", Source{FS: mapFS, Path: "static/*.{css"}, true, "", "", ""},
+		{"Glob Not Folder", Source{FS: mapFS, Path: "static/index.html/*.css"}, true, "", "", ""},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			src, err := newSource(test.source)
+
+			if err == nil {
+				if test.hasError {
+					t.Errorf("newSource did not return an error")
+				} else {
+					if src.root != test.root {
+						t.Errorf("Did not get expected root got (%s) expected (%s)", src.root, test.root)
+					}
+					if src.mount != test.mount {
+						t.Errorf("Did not get expected mount got (%s) expected (%s)", src.mount, test.mount)
+					}
+					if local := src.localPath(""); local != test.local {
+						t.Errorf("Did not get expected local got (%s) expected (%s)", local, test.local)
+					}
+				}
+			} else {
+				if !test.hasError {
+					t.Errorf("newSource returned unexpected error %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestSourceSkip(t *testing.T) {
+	src := &source{
+		root:    "static",
+		include: []string{"*.html", "css/*.css"},
+		ignore:  []string{"skip.html"},
+	}
+
+	for _, test := range []struct {
+		name string
+		skip bool
+	}{
+		{"index.html", false},
+		{"pages/index.html", false},
+		{"css/main.css", false},
+		{"css/extra/main.css", true},
+		{"main.js", true},
+		{"skip.html", true},
+		{"pages/skip.html", true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if skip := src.skip(test.name); skip != test.skip {
+				t.Errorf("Did not get expected skip for %s got (%v) expected (%v)", test.name, skip, test.skip)
+			}
+		})
+	}
+}
@@ -10,10 +10,14 @@ import (
 	"strings"
 )
 
+// builder collects the strings of the generated code into one table, an entry
+// that is a prefix or a suffix of a longer entry, or a part between separators
+// such as a directory of a file name, shares its bytes.
 type builder struct {
 	list   []string
 	str    string
 	offset int
+	known  map[string]int
 }
 
 func (s *builder) add(entry string) {
@@ -24,26 +28,126 @@ func (s *builder) write(w writer) error {
 	var builder strings.Builder
 
 	s.offset = w.offset()
+	s.known = make(map[string]int)
 
-	sort.Slice(s.list, func(i, j int) bool { return len(s.list[i]) > len(s.list[j]) })
-	s.str = builder.String()
+	// Longest first so shorter entries can be found inside them, ties are
+	// ordered so the table does not depend on the order entries were added
+	sort.Slice(s.list, func(i, j int) bool {
+		if len(s.list[i]) != len(s.list[j]) {
+			return len(s.list[i]) > len(s.list[j])
+		}
+		return s.list[i] < s.list[j]
+	})
+
+	prefixes, suffixes := containers(s.list)
 
 	for _, entry := range s.list {
-		if !strings.Contains(s.str, entry) {
+		if _, ok := s.known[entry]; ok || len(entry) == 0 {
+			continue
+		}
+
+		// Containers are longer so already in the table
+		if container, ok := prefixes[entry]; ok {
+			s.remember(entry, s.known[container])
+		} else if container, ok := suffixes[entry]; ok {
+			s.remember(entry, s.known[container]+len(container)-len(entry))
+		} else {
+			s.learn(entry, builder.Len())
 			builder.WriteString(entry)
-			s.str = builder.String()
 		}
 	}
 
+	s.str = builder.String()
+
 	_, err := w.Write([]byte(s.str))
 
 	return err
 }
 
+// containers maps the entries that are a prefix or a suffix of a longer entry to
+// that entry. Once sorted an entry is followed by the entries it starts, so only
+// neighbours are compared, suffixes are found the same way on reversed entries.
+func containers(list []string) (prefixes map[string]string, suffixes map[string]string) {
+	prefixes = make(map[string]string)
+	suffixes = make(map[string]string)
+
+	sorted := make([]string, len(list))
+	copy(sorted, list)
+	sort.Strings(sorted)
+
+	for i := 0; i+1 < len(sorted); i++ {
+		if len(sorted[i]) < len(sorted[i+1]) && strings.HasPrefix(sorted[i+1], sorted[i]) {
+			prefixes[sorted[i]] = sorted[i+1]
+		}
+	}
+
+	for i, entry := range list {
+		sorted[i] = reverse(entry)
+	}
+	sort.Strings(sorted)
+
+	for i := 0; i+1 < len(sorted); i++ {
+		if len(sorted[i]) < len(sorted[i+1]) && strings.HasPrefix(sorted[i+1], sorted[i]) {
+			suffixes[reverse(sorted[i])] = reverse(sorted[i+1])
+		}
+	}
+
+	return
+}
+
+// reverse returns the bytes of str in reverse order
+func reverse(str string) string {
+	b := make([]byte, len(str))
+	for i := range b {
+		b[i] = str[len(str)-1-i]
+	}
+
+	return string(b)
+}
+
+// learn records the position of entry, of its prefixes ending and suffixes
+// starting at a separator and of the parts between separators, so later
+// entries are found without searching the table
+func (s *builder) learn(entry string, pos int) {
+	last := -1
+
+	for i := 0; i < len(entry); i++ {
+		if separator(entry[i]) {
+			s.remember(entry[:i], pos)
+			s.remember(entry[:i+1], pos)
+			s.remember(entry[i:], pos+i)
+			s.remember(entry[i+1:], pos+i+1)
+
+			if last >= 0 {
+				s.remember(entry[last:i], pos+last)
+				s.remember(entry[last+1:i], pos+last+1)
+			}
+
+			last = i
+		}
+	}
+
+	s.remember(entry, pos)
+}
+
+// remember keeps the first position of str, entries are written in order
+// so it is also the lowest
+func (s *builder) remember(str string, pos int) {
+	if _, ok := s.known[str]; !ok && len(str) > 0 {
+		s.known[str] = pos
+	}
+}
+
 func (s *builder) slice(entry string) string {
-	if pos := strings.Index(s.str, entry); pos >= 0 && len(entry) > 0 {
+	if pos, ok := s.known[entry]; ok && len(entry) > 0 {
 		return fmt.Sprintf("/* %s */ str[%d:%d]", s.str[pos:pos+len(entry)], s.offset+pos, s.offset+pos+len(entry))
 	}
 
 	return `"` + entry + `"`
 }
+
+// separator returns true for the bytes entries are split at, such as the
+// slashes of paths, the dots of extensions and the spaces of mime types
+func separator(c byte) bool {
+	return c == '/' || c == '.' || c == ' ' || c == ';' || c == '='
+}
@@ -5,6 +5,7 @@ package embed
 // license that can be found in the LICENSE.md file.
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 )
@@ -53,12 +54,91 @@ func TestStringsBuilder(t *testing.T) {
 
 }
 
+func TestStringsShared(t *testing.T) {
+	entries := []string{
+		"/assets/fonts/icons.woff2",
+		"/assets/fonts/icons.woff",
+		"assets/fonts/icons.woff",
+		"icons.woff2",
+		"font/woff2",
+		"font/woff",
+		"fonts",
+		"/assets",
+		"text/css; charset=utf-8",
+		"text/css",
+		"utf-8",
+	}
+
+	var table string
+
+	for _, test := range []struct {
+		name  string
+		order []int
+	}{
+		{"Forward", []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{"Backward", []int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0}},
+		{"Mixed", []int{5, 2, 8, 0, 10, 3, 7, 1, 9, 4, 6, 0, 5}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var builder builder
+
+			for _, i := range test.order {
+				builder.add(entries[i])
+			}
+
+			builder.write(&mocWriter{})
+
+			expect := "/assets/fonts/icons.woff2text/css; charset=utf-8font/woff2"
+			if builder.str != expect {
+				t.Errorf("Did not get expected table got (%s) expected (%s)", builder.str, expect)
+			}
+
+			if len(table) > 0 && builder.str != table {
+				t.Errorf("Table depends on the order entries were added")
+			}
+			table = builder.str
+
+			for _, entry := range entries {
+				if s, expect := builder.slice(entry), fmt.Sprintf("/* %s */", entry); s[:len(expect)] != expect {
+					t.Errorf("Did not get expected for %s got (%s)", entry, s)
+				}
+			}
+		})
+	}
+}
+
+func TestStringsScale(t *testing.T) {
+	var builder builder
+
+	for i := 0; i < 20000; i++ {
+		name := fmt.Sprintf("/assets/dir%d/file-%d.js", i%100, i)
+		builder.add(name)
+		builder.add(name[1:])
+		builder.add(fmt.Sprintf("file-%d.js", i))
+		builder.add(fmt.Sprintf("dir%d", i%100))
+	}
+
+	builder.write(&mocWriter{})
+
+	for _, entry := range builder.list {
+		var start, end int
+
+		if _, err := fmt.Sscanf(builder.slice(entry)[len(entry)+7:], "str[%d:%d]", &start, &end); err != nil {
+			t.Fatalf("Entry %s not in the table %v", entry, err)
+		}
+
+		if builder.str[start:end] != entry {
+			t.Fatalf("Did not get expected for %s got (%s)", entry, builder.str[start:end])
+		}
+	}
+}
+
 type mocWriter struct {
 	bytes []byte
 }
 
 func (m *mocWriter) offset() int {
-	return 0
+	return len(m.bytes)
 }
 
 func (m *mocWriter) Write(p []byte) (n int, err error) {
@@ -0,0 +1,227 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/inabyte/embed/embedded"
+)
+
+const (
+	// SymlinksFollow embeds the file or directory a symbolic link points to
+	SymlinksFollow = "follow"
+	// SymlinksSkip ignores symbolic links
+	SymlinksSkip = "skip"
+	// SymlinksLink stores symbolic links as links to their target in the embedded files
+	SymlinksLink = "link"
+)
+
+// link is a symbolic link stored in the embedded files
+type link struct {
+	name     string
+	baseName string
+	target   string
+	path     string
+	ModTime  int64
+}
+
+// scanDir a directory being scanned, used to detect symbolic link cycles
+type scanDir struct {
+	rel  string
+	info fs.FileInfo
+}
+
+// parseSymlinks checks the symbolic link policy, empty is SymlinksFollow
+func parseSymlinks(policy string) (string, error) {
+	switch policy {
+	case "":
+		return SymlinksFollow, nil
+	case SymlinksFollow, SymlinksSkip, SymlinksLink:
+		return policy, nil
+	}
+
+	return "", fmt.Errorf("Symlinks %q is not one of %s, %s or %s", policy, SymlinksFollow, SymlinksSkip, SymlinksLink)
+}
+
+// scanLink scans the symbolic link rel relative to the root according to the symbolic link policy
+func (gen *generate) scanLink(src *source, rel string, entry fs.DirEntry) (modTime int64, skip bool, err error) {
+	var info fs.FileInfo
+
+	fpath := src.path(rel)
+
+	switch gen.symlinks {
+	case SymlinksSkip:
+		skip = true
+	case SymlinksLink:
+		if skip = gen.skip(fpath) || src.skip(rel); !skip {
+			var target string
+
+			n := gen.rename(src.canonicalName(rel))
+
+			if target, err = src.linkTarget(rel); err == nil {
+				target = gen.rename(target)
+				skip, err = gen.claim(n, fpath)
+			}
+
+			if err == nil && !skip {
+				info, err = entry.Info()
+			}
+
+			if err == nil && !skip {
+				modTime = gen.getModTime(src.localPath(rel), info)
+				gen.Links = append(gen.Links, &link{
+					name:     n,
+					baseName: path.Base(n),
+					target:   target,
+					path:     fpath,
+					ModTime:  modTime,
+				})
+				gen.setLast(modTime)
+			}
+		}
+	default:
+		if info, err = fs.Stat(src.fsys, src.name(rel)); err != nil {
+			err = fmt.Errorf("%s: symlink can not be followed: %v", fpath, err)
+		} else if info.IsDir() {
+			for _, parent := range src.dirs {
+				if os.SameFile(parent.info, info) {
+					err = fmt.Errorf("%s: symlink cycle back to %s", fpath, src.path(parent.rel))
+					break
+				}
+			}
+
+			// os.SameFile only knows the FileInfo of the os package, bound the
+			// directory links followed for the other file systems
+			if err == nil && src.links >= embedded.MaxLinks {
+				err = fmt.Errorf("%s: symlink cycle, more than %d directory links followed", fpath, embedded.MaxLinks)
+			}
+		}
+
+		if err == nil {
+			if info.IsDir() {
+				src.links++
+			}
+
+			modTime, skip, err = gen.scan(src, rel, info)
+
+			if info.IsDir() {
+				src.links--
+			}
+		}
+	}
+
+	return
+}
+
+// linkTarget returns the embedded name the symbolic link rel relative to the root points to
+func (s *source) linkTarget(rel string) (target string, err error) {
+	var dest string
+
+	local := s.localPath(rel)
+
+	if len(local) == 0 {
+		err = fmt.Errorf("%s: symlink can not be read", s.path(rel))
+	} else if dest, err = os.Readlink(local); err == nil {
+		if !filepath.IsAbs(dest) {
+			dest = filepath.Join(filepath.Dir(local), dest)
+		}
+
+		if r, e := filepath.Rel(s.local, dest); e != nil || r == ".." || strings.HasPrefix(r, ".."+string(filepath.Separator)) {
+			err = fmt.Errorf("%s: symlink target %s is outside %s", local, dest, s.local)
+		} else {
+			target = s.canonicalName(filepath.ToSlash(r))
+		}
+	}
+
+	return
+}
+
+// resolveLinks updates the targets of links to fingerprinted files and checks
+// every link resolves to an embedded file or directory
+func (gen *generate) resolveLinks() (err error) {
+	names := make(map[string]string, len(gen.Files)+len(gen.Dirs)+len(gen.Links))
+	renamed := make(map[string]string, len(gen.Fingerprints))
+
+	for _, entry := range gen.Fingerprints {
+		renamed[entry.Logical] = entry.Name
+	}
+
+	for _, f := range gen.Files {
+		names[f.name] = ""
+	}
+
+	for _, d := range gen.Dirs {
+		names[d.name] = ""
+	}
+
+	for _, l := range gen.Links {
+		if n, ok := renamed[l.target]; ok {
+			l.target = n
+		}
+		names[l.name] = l.target
+	}
+
+	for _, l := range gen.Links {
+		if err == nil {
+			err = l.resolve(names)
+		}
+	}
+
+	return
+}
+
+// resolve follows the link through names, a map of embedded names to link targets,
+// the same way the embedded file system does when it is opened
+func (l *link) resolve(names map[string]string) error {
+	name := l.name
+
+	for links := 0; links <= embedded.MaxLinks; links++ {
+		target, ok := names[name]
+
+		switch {
+		case ok && len(target) == 0:
+			return nil
+		case ok:
+			name = target
+		default:
+			dir := path.Dir(name)
+			for target, ok = names[dir]; !ok && dir != "/"; target, ok = names[dir] {
+				dir = path.Dir(dir)
+			}
+
+			if !ok || len(target) == 0 {
+				return fmt.Errorf("%s: symlink target %s is not embedded", l.path, l.target)
+			}
+
+			name = path.Join(target, strings.TrimPrefix(name, dir))
+		}
+	}
+
+	return fmt.Errorf("%s: symlink cycle resolving %s", l.path, l.target)
+}
+
+func (l *link) set() {
+	stringer.add(l.name)
+	stringer.add(l.baseName)
+	stringer.add(l.target)
+}
+
+func (l *link) Name() string {
+	return stringer.slice(l.name)
+}
+
+func (l *link) BaseName() string {
+	return stringer.slice(l.baseName)
+}
+
+func (l *link) Target() string {
+	return stringer.slice(l.target)
+}
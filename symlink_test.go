@@ -0,0 +1,183 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSymlinks(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		policy string
+		links  map[string]string
+		files  []string
+		expect map[string]string
+		hasErr bool
+	}{
+		{
+			name:   "Follow",
+			policy: "",
+			links:  map[string]string{"home.html": "index.html", "docs": "sub"},
+			files:  []string{"/docs/a.txt", "/home.html", "/index.html", "/sub/a.txt"},
+		},
+		{
+			name:   "Follow Cycle",
+			policy: SymlinksFollow,
+			links:  map[string]string{"sub/up": ".."},
+			hasErr: true,
+		},
+		{
+			name:   "Follow Dangling",
+			policy: SymlinksFollow,
+			links:  map[string]string{"gone.html": "missing.html"},
+			hasErr: true,
+		},
+		{
+			name:   "Skip",
+			policy: SymlinksSkip,
+			links:  map[string]string{"home.html": "index.html", "up": "."},
+			files:  []string{"/index.html", "/sub/a.txt"},
+		},
+		{
+			name:   "Link",
+			policy: SymlinksLink,
+			links:  map[string]string{"home.html": "index.html", "docs": "sub", "up": ".", "sub/top.html": "../home.html"},
+			files:  []string{"/index.html", "/sub/a.txt"},
+			expect: map[string]string{"/docs": "/sub", "/home.html": "/index.html", "/sub/top.html": "/home.html", "/up": "/"},
+		},
+		{
+			name:   "Link Outside",
+			policy: SymlinksLink,
+			links:  map[string]string{"out": "../.."},
+			hasErr: true,
+		},
+		{
+			name:   "Link Not Embedded",
+			policy: SymlinksLink,
+			links:  map[string]string{"gone.html": "missing.html"},
+			hasErr: true,
+		},
+		{
+			name:   "Link Cycle",
+			policy: SymlinksLink,
+			links:  map[string]string{"a": "b", "b": "a"},
+			hasErr: true,
+		},
+		{
+			name:   "Bad Policy",
+			policy: "copy",
+			hasErr: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var gen generate
+
+			base, err := ioutil.TempDir("", "symlink-test")
+
+			if len(base) > 0 {
+				defer os.RemoveAll(base)
+			}
+
+			www := filepath.Join(base, "www")
+
+			if err == nil {
+				err = os.MkdirAll(filepath.Join(www, "sub"), os.ModePerm)
+			}
+
+			if err == nil {
+				err = ioutil.WriteFile(filepath.Join(www, "index.html"), []byte("<html></html>"), os.ModePerm)
+			}
+
+			if err == nil {
+				err = ioutil.WriteFile(filepath.Join(www, "sub", "a.txt"), []byte("a"), os.ModePerm)
+			}
+
+			if err != nil {
+				t.Fatalf("unable to cerate fs %v", err)
+			}
+
+			for name, target := range test.links {
+				if err := os.Symlink(filepath.FromSlash(target), filepath.Join(www, filepath.FromSlash(name))); err != nil {
+					t.Skipf("unable to create symlink %v", err)
+				}
+			}
+
+			config := New()
+			config.Output = filepath.Join(base, "assets", "files")
+			config.Files = []string{www}
+			config.Symlinks = test.policy
+
+			err = gen.generate(config)
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("Generate did not return an error")
+				}
+			} else if !test.hasErr {
+				t.Fatalf("Generate returned unexpected error %v", err)
+			}
+
+			if err == nil {
+				var files []string
+				for _, f := range gen.Files {
+					files = append(files, f.name)
+				}
+
+				if !reflect.DeepEqual(files, test.files) {
+					t.Errorf("Did not get expected files got (%v) expected (%v)", files, test.files)
+				}
+
+				var links map[string]string
+				for _, l := range gen.Links {
+					if links == nil {
+						links = make(map[string]string)
+					}
+					links[l.name] = l.target
+				}
+
+				if !reflect.DeepEqual(links, test.expect) {
+					t.Errorf("Did not get expected links got (%v) expected (%v)", links, test.expect)
+				}
+			}
+		})
+	}
+}
+
+func TestSymlinkCycleFS(t *testing.T) {
+	var gen generate
+
+	base, err := ioutil.TempDir("", "symlink-fs-test")
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	// The FileInfo of the link is not from the os package, os.SameFile can not
+	// tell the link points back to its directory
+	config := New()
+	config.Output = filepath.Join(base, "assets", "files")
+	config.Sources = []Source{{FS: fstest.MapFS{
+		"www/index.html": &fstest.MapFile{Data: []byte("index")},
+		"www/loop":       &fstest.MapFile{Data: []byte("."), Mode: fs.ModeSymlink},
+	}, Path: "www"}}
+
+	if err = gen.generate(config); err == nil {
+		t.Errorf("Generate did not return an error")
+	} else if !strings.Contains(err.Error(), "symlink cycle") {
+		t.Errorf("Generate returned unexpected error %v", err)
+	}
+}
@@ -0,0 +1,183 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// Transformer changes the contents of files before they are minified and compressed
+type Transformer interface {
+	// Transform is called with the embedded name, the mime type and the contents
+	// of a file, it returns the new contents or nil to leave the file unchanged.
+	Transform(name, mimeType string, data []byte) (*Transformed, error)
+}
+
+// TransformerFunc is a function used as a Transformer
+type TransformerFunc func(name, mimeType string, data []byte) (*Transformed, error)
+
+// Transform calls fn(name, mimeType, data)
+func (fn TransformerFunc) Transform(name, mimeType string, data []byte) (*Transformed, error) {
+	return fn(name, mimeType, data)
+}
+
+// Transformed is the result of a Transformer
+type Transformed struct {
+	// Data is the new contents of the file.
+	Data []byte
+	// Name, if set, is the new embedded name of the file.
+	Name string
+	// MimeType, if set, is the new mime type of the file, otherwise a new name
+	// is looked up in the mime type table.
+	MimeType string
+	// ModTime, if not zero, is the new modification time as a Unix timestamp.
+	// It is ignored when Config.ModifyTime or Config.GitModifyTime is set and
+	// clamped to SOURCE_DATE_EPOCH.
+	ModTime int64
+}
+
+// Transform registers a Transformer for files matching a glob pattern
+type Transform struct {
+	// Pattern is the glob pattern matched against embedded names without the
+	// leading slash, a pattern without a slash is matched against the base name.
+	Pattern string
+	// Transformer is run for every matching file.
+	Transformer Transformer
+}
+
+type transformRule struct {
+	glob     [][]string
+	baseName bool
+	fn       func(f *file, data []byte) (*Transformed, error)
+}
+
+type transformer struct {
+	rules     []transformRule
+	mimeTypes map[string]string
+	fixedTime bool
+	epoch     *int64
+}
+
+// newTransformer compiles the commands followed by the transforms, nil if there are none
+func newTransformer(commands []Command, list []Transform, mimeTypes map[string]string, c *cache) (t *transformer, err error) {
+	if len(commands)+len(list) > 0 {
+		t = &transformer{mimeTypes: mimeTypes}
+
+		for _, entry := range commands {
+			var cmd *command
+
+			if cmd, err = newCommand(entry, c); err == nil {
+				err = t.add(entry.Pattern, cmd.run)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, entry := range list {
+			transformer := entry.Transformer
+
+			if err = t.add(entry.Pattern, func(f *file, data []byte) (*Transformed, error) {
+				return transformer.Transform(f.name, f.mimeType, data)
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return
+}
+
+// add adds a rule running fn on the files matching pattern
+func (t *transformer) add(pattern string, fn func(f *file, data []byte) (*Transformed, error)) (err error) {
+	rule := transformRule{fn: fn, baseName: !strings.Contains(pattern, "/")}
+
+	if rule.glob, err = compileGlob(pattern); err != nil {
+		err = fmt.Errorf("transform %s: %v", pattern, err)
+	} else {
+		t.rules = append(t.rules, rule)
+	}
+
+	return
+}
+
+// match returns true if the embedded name matches the rule
+func (r *transformRule) match(name string) bool {
+	if r.baseName {
+		name = path.Base(name)
+	}
+	return matchGlob(r.glob, strings.TrimPrefix(name, "/"))
+}
+
+// transform runs the matching transformers in order on the file data, each is
+// matched against the name the one before it gave the file
+func (t *transformer) transform(f *file, data []byte) ([]byte, error) {
+	for _, rule := range t.rules {
+		if rule.match(f.name) {
+			res, err := rule.fn(f, data)
+
+			if err != nil {
+				return nil, fmt.Errorf("%s: transform failed: %v", f.name, err)
+			}
+
+			if res != nil {
+				data = res.Data
+
+				if len(res.Name) > 0 && res.Name != f.name {
+					f.name = path.Clean("/" + res.Name)
+					f.baseName = path.Base(f.name)
+
+					if len(res.MimeType) == 0 {
+						if f.mimeType = t.mimeTypes[strings.ToLower(path.Ext(f.name))]; f.mimeType == "" {
+							f.mimeType = http.DetectContentType(data)
+						}
+					}
+				}
+
+				if len(res.MimeType) > 0 {
+					f.mimeType = withCharset(res.MimeType)
+				}
+
+				// Config.ModifyTime and git times are kept, like file times
+				// the time is clamped to SOURCE_DATE_EPOCH
+				if res.ModTime != 0 && !t.fixedTime {
+					f.ModTime = res.ModTime
+					if t.epoch != nil && f.ModTime > *t.epoch {
+						f.ModTime = *t.epoch
+					}
+				}
+			}
+		}
+	}
+
+	return data, nil
+}
+
+// transformed claims the new names transformers gave files, names are the names
+// before, and moves the files in to their new directories. A new name used by
+// another file is resolved by the conflict policy.
+func (gen *generate) transformed(names []string) (err error) {
+	var renamed []*file
+
+	// Release every old name first so files can swap names
+	for i, f := range gen.Files {
+		if f.name != names[i] {
+			delete(gen.processed, names[i])
+			renamed = append(renamed, f)
+		}
+	}
+
+	if len(renamed) > 0 {
+		if _, err = gen.reclaim(renamed); err == nil {
+			gen.reparent()
+		}
+	}
+
+	return
+}
@@ -0,0 +1,293 @@
+package embed
+
+// Copyright 2020 Inabyte Inc. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestTransform(t *testing.T) {
+	base, err := ioutil.TempDir("", "transform-test")
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	files := fstest.MapFS{
+		"index.html":   &fstest.MapFile{Data: []byte("index")},
+		"app.js":       &fstest.MapFile{Data: []byte("app")},
+		"docs/a.md":    &fstest.MapFile{Data: []byte("a")},
+		"lib/util.js":  &fstest.MapFile{Data: []byte("util")},
+		"data/raw.bin": &fstest.MapFile{Data: []byte("raw")},
+	}
+
+	header := TransformerFunc(func(name, mimeType string, data []byte) (*Transformed, error) {
+		return &Transformed{Data: append([]byte("/* "+name+" */"), data...)}, nil
+	})
+
+	markdown := func(dir string) Transformer {
+		return TransformerFunc(func(name, mimeType string, data []byte) (*Transformed, error) {
+			if mimeType != "text/markdown; charset=utf-8" {
+				return nil, fmt.Errorf("unexpected mime type %s", mimeType)
+			}
+			return &Transformed{
+				Data: []byte("<p>" + string(data) + "</p>"),
+				Name: dir + strings.TrimSuffix(filepath.Base(name), ".md") + ".html",
+			}, nil
+		})
+	}
+
+	for _, test := range []struct {
+		name       string
+		transforms []Transform
+		expect     map[string]string
+		mimeTypes  map[string]string
+		dirs       map[string][]string
+		conflict   string
+		hasErr     bool
+	}{
+		{
+			name:       "Header",
+			transforms: []Transform{{"*.js", header}},
+			expect: map[string]string{
+				"/index.html":   "index",
+				"/app.js":       "/* /app.js */app",
+				"/docs/a.md":    "a",
+				"/lib/util.js":  "/* /lib/util.js */util",
+				"/data/raw.bin": "raw",
+			},
+		},
+		{
+			name:       "Path Pattern",
+			transforms: []Transform{{"lib/**/*.js", header}},
+			expect: map[string]string{
+				"/index.html":   "index",
+				"/app.js":       "app",
+				"/docs/a.md":    "a",
+				"/lib/util.js":  "/* /lib/util.js */util",
+				"/data/raw.bin": "raw",
+			},
+		},
+		{
+			name:       "Rename",
+			transforms: []Transform{{"*.md", markdown("/docs/")}, {"docs/*.html", header}},
+			expect: map[string]string{
+				"/index.html":   "index",
+				"/app.js":       "app",
+				"/docs/a.html":  "/* /docs/a.html */<p>a</p>",
+				"/lib/util.js":  "util",
+				"/data/raw.bin": "raw",
+			},
+			mimeTypes: map[string]string{"/docs/a.html": "text/html; charset=utf-8"},
+		},
+		{
+			name:       "Move",
+			transforms: []Transform{{"*.md", markdown("/pages/")}},
+			expect: map[string]string{
+				"/index.html":   "index",
+				"/app.js":       "app",
+				"/pages/a.html": "<p>a</p>",
+				"/lib/util.js":  "util",
+				"/data/raw.bin": "raw",
+			},
+			dirs: map[string][]string{
+				"/":      {"/app.js", "/data", "/index.html", "/lib", "/pages"},
+				"/data":  {"/data/raw.bin"},
+				"/lib":   {"/lib/util.js"},
+				"/pages": {"/pages/a.html"},
+			},
+		},
+		{
+			name: "Metadata",
+			transforms: []Transform{{"*.bin", TransformerFunc(func(name, mimeType string, data []byte) (*Transformed, error) {
+				return &Transformed{Data: data, MimeType: "text/x-raw", ModTime: 1600000000}, nil
+			})}, {"*.html", TransformerFunc(func(name, mimeType string, data []byte) (*Transformed, error) {
+				return nil, nil
+			})}},
+			expect: map[string]string{
+				"/index.html":   "index",
+				"/app.js":       "app",
+				"/docs/a.md":    "a",
+				"/lib/util.js":  "util",
+				"/data/raw.bin": "raw",
+			},
+			mimeTypes: map[string]string{"/data/raw.bin": "text/x-raw; charset=utf-8"},
+		},
+		{
+			name: "Duplicate",
+			transforms: []Transform{{"*.md", TransformerFunc(func(name, mimeType string, data []byte) (*Transformed, error) {
+				return &Transformed{Data: data, Name: "/index.html"}, nil
+			})}},
+			hasErr: true,
+		},
+		{
+			name: "Duplicate First",
+			transforms: []Transform{{"*.md", TransformerFunc(func(name, mimeType string, data []byte) (*Transformed, error) {
+				return &Transformed{Data: data, Name: "/index.html"}, nil
+			})}},
+			conflict: ConflictFirst,
+			expect: map[string]string{
+				"/index.html":   "index",
+				"/app.js":       "app",
+				"/lib/util.js":  "util",
+				"/data/raw.bin": "raw",
+			},
+			dirs: map[string][]string{
+				"/":     {"/app.js", "/data", "/index.html", "/lib"},
+				"/data": {"/data/raw.bin"},
+				"/lib":  {"/lib/util.js"},
+			},
+		},
+		{
+			name: "Duplicate Last",
+			transforms: []Transform{{"*.md", TransformerFunc(func(name, mimeType string, data []byte) (*Transformed, error) {
+				return &Transformed{Data: data, Name: "/index.html"}, nil
+			})}},
+			conflict: ConflictLast,
+			expect: map[string]string{
+				"/index.html":   "a",
+				"/app.js":       "app",
+				"/lib/util.js":  "util",
+				"/data/raw.bin": "raw",
+			},
+		},
+		{
+			name: "Error",
+			transforms: []Transform{{"*.js", TransformerFunc(func(name, mimeType string, data []byte) (*Transformed, error) {
+				return nil, fmt.Errorf("broken")
+			})}},
+			hasErr: true,
+		},
+		{
+			name:       "Bad Pattern",
+			transforms: []Transform{{"{*.js", header}},
+			hasErr:     true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var gen generate
+
+			config := New()
+			config.Output = filepath.Join(base, "assets", "files")
+			config.Transforms = test.transforms
+			config.DisableCompression = true
+			config.Minify = ""
+			config.ModifyTime = "1"
+			config.Conflict = test.conflict
+			config.Sources = []Source{{FS: files}}
+
+			err := gen.generate(config)
+
+			if err == nil {
+				if test.hasErr {
+					t.Errorf("Generate did not return an error")
+				}
+			} else if !test.hasErr {
+				t.Fatalf("Generate returned unexpected error %v", err)
+			}
+
+			if err == nil {
+				tags := make(map[string]string)
+				for _, f := range gen.Files {
+					tags[f.name] = f.tag
+
+					if expect, ok := test.mimeTypes[f.name]; ok && f.mimeType != expect {
+						t.Errorf("Did not get expected mime type for %s got (%s) expected (%s)", f.name, f.mimeType, expect)
+					}
+
+					// Config.ModifyTime takes precedence
+					if f.ModTime != 1 {
+						t.Errorf("Did not get expected modification time for %s got (%d)", f.name, f.ModTime)
+					}
+				}
+
+				expect := make(map[string]string)
+				for name, contents := range test.expect {
+					sum := sha1.Sum([]byte(contents))
+					expect[name] = base64.RawURLEncoding.EncodeToString(sum[:])
+				}
+
+				if !reflect.DeepEqual(tags, expect) {
+					t.Errorf("Did not get expected files got (%v) expected (%v)", tags, test.expect)
+				}
+
+				if test.dirs != nil {
+					dirs := make(map[string][]string)
+					for _, d := range gen.Dirs {
+						var list []string
+						for name := range d.files {
+							list = append(list, name)
+						}
+						sort.Strings(list)
+						dirs[d.name] = list
+					}
+
+					if !reflect.DeepEqual(dirs, test.dirs) {
+						t.Errorf("Did not get expected dirs got (%v) expected (%v)", dirs, test.dirs)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestTransformModTime(t *testing.T) {
+	defer os.Setenv(SourceDateEpoch, os.Getenv(SourceDateEpoch))
+
+	files := fstest.MapFS{
+		"raw.bin": &fstest.MapFile{Data: []byte("raw"), ModTime: time.Unix(1400000000, 0)},
+	}
+
+	transforms := []Transform{{"*.bin", TransformerFunc(func(name, mimeType string, data []byte) (*Transformed, error) {
+		return &Transformed{Data: data, ModTime: 1600000000}, nil
+	})}}
+
+	for _, test := range []struct {
+		name       string
+		modifyTime string
+		epoch      string
+		expect     int64
+	}{
+		{"Transformed", "", "", 1600000000},
+		{"Modify Time", "1", "", 1},
+		{"Epoch", "", "1500000000", 1500000000},
+		{"After Epoch", "", "1700000000", 1600000000},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var gen generate
+
+			os.Setenv(SourceDateEpoch, test.epoch)
+
+			config := New()
+			config.Output = filepath.Join(t.TempDir(), "assets", "files")
+			config.Transforms = transforms
+			config.ModifyTime = test.modifyTime
+			config.Sources = []Source{{FS: files}}
+
+			if err := gen.generate(config); err != nil {
+				t.Fatalf("Generate returned unexpected error %v", err)
+			}
+
+			if m := gen.Files[0].ModTime; m != test.expect {
+				t.Errorf("Did not get expected modification time got (%d) expected (%d)", m, test.expect)
+			}
+		})
+	}
+}
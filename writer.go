@@ -5,8 +5,11 @@ package embed
 // license that can be found in the LICENSE.md file.
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -22,46 +25,111 @@ type writer interface {
 	offset() int
 }
 
+// blob writes contents to the data, identical contents are written once and
+// share the offset of the first copy.
+type blob struct {
+	w       writer
+	offsets map[[sha256.Size]byte]int
+	copies  int
+	saved   int
+}
+
 type fileWriter struct {
 	name        string
 	isGo        bool
 	buf         [lineSize]byte
 	strBuf      [lineSize * 4]byte
 	index       int
-	f           *os.File
+	f           *outputFile
 	dataOffset  int
 	writeOffset int
 }
 
-func createFile(path string, name string, extension string) (file *os.File, err error) {
+// outputFile buffers the contents of a generated file, the file is only
+// written on Close if the contents differ from the file on disk. When check
+// is set the file is never written, differences are recorded instead.
+type outputFile struct {
+	bytes.Buffer
+	name  string
+	check *checker
+}
+
+func newBlob(w writer) *blob {
+	return &blob{w: w, offsets: make(map[[sha256.Size]byte]int)}
+}
+
+// write writes data unless identical contents were already written,
+// returning the offset and size of the contents in the data.
+func (b *blob) write(data []byte) (offset int, size int, err error) {
+	key := sha256.Sum256(data)
+	size = len(data)
+
+	if o, ok := b.offsets[key]; ok && size > 0 {
+		offset = o
+		b.copies++
+		b.saved += size
+	} else {
+		offset = b.w.offset()
+		if size, err = b.w.Write(data); err == nil {
+			b.offsets[key] = offset
+		}
+	}
+
+	return
+}
+
+func createFile(path string, name string, extension string) (file *outputFile, err error) {
+	return &outputFile{name: fmt.Sprintf("%s%s%s", path, name, extension)}, nil
+}
+
+// Close write the file if the contents changed
+func (f *outputFile) Close() (err error) {
+	b, e := ioutil.ReadFile(f.name)
+	if e == nil && bytes.Equal(b, f.Bytes()) {
+		return
+	}
+
+	if f.check != nil {
+		f.check.add(f.name, b, f.Bytes())
+		return
+	}
 
-	basePath := filepath.Dir(path)
+	basePath := filepath.Dir(f.name)
 
 	if len(basePath) > 0 && basePath != "." && basePath != string(filepath.Separator) {
 		err = os.MkdirAll(basePath, os.ModePerm)
 	}
 
 	if err == nil {
-		file, err = os.Create(fmt.Sprintf("%s%s%s", path, name, extension))
+		err = ioutil.WriteFile(f.name, f.Bytes(), 0666)
 	}
 
 	return
 }
 
 func createWriter(isGo bool, pkg string, name string, path string, tags ...string) (w writer, err error) {
-	var file *os.File
+	var file *outputFile
 
+	if file, err = createDataFile(isGo, path); err == nil {
+		w, err = newWriter(file, isGo, pkg, name, tags...)
+	}
+
+	return
+}
+
+func createDataFile(isGo bool, path string) (file *outputFile, err error) {
 	ext := ".s"
 	if isGo {
 		ext = ".go"
 	}
 
+	return createFile(path, "_data", ext)
+}
+
+func newWriter(file *outputFile, isGo bool, pkg string, name string, tags ...string) (w writer, err error) {
 	buildTags := strings.TrimSpace(strings.Join(tags, " "))
-	file, err = createFile(path, "_data", ext)
 
-	if err == nil {
-		_, err = file.WriteString(header)
-	}
+	_, err = file.WriteString(header)
 
 	if err == nil && len(buildTags) > 0 {
 		_, err = file.WriteString("\n// +build " + buildTags)
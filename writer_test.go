@@ -5,11 +5,17 @@ package embed
 // license that can be found in the LICENSE.md file.
 
 import (
+	"bytes"
+	"fmt"
 	"io/ioutil"
+	"log"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"testing/fstest"
+	"time"
 )
 
 var data = []byte(`// Code generated by embed. DO NOT EDIT.
@@ -94,3 +100,154 @@ func checkFile(t *testing.T, path string, name string, data []byte) {
 		}
 	}
 }
+
+func TestOutputFile(t *testing.T) {
+	tmpdir, _ := ioutil.TempDir("", "output-test")
+	defer os.RemoveAll(tmpdir)
+
+	name := filepath.Join(tmpdir, "sub", "files.go")
+	old := time.Unix(1579282495, 0)
+
+	for _, test := range []struct {
+		name     string
+		contents string
+		touched  bool
+	}{
+		{"Create", "package files\n", true},
+		{"Unchanged", "package files\n", false},
+		{"Changed", "package assets\n", true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			os.Chtimes(name, old, old)
+
+			file, err := createFile(filepath.Join(tmpdir, "sub", "files"), "", ".go")
+
+			if err == nil {
+				file.WriteString(test.contents)
+				err = file.Close()
+			}
+
+			if err != nil {
+				t.Fatalf("Could not write file %v", err)
+			}
+
+			checkFile(t, filepath.Join(tmpdir, "sub"), "files.go", []byte(test.contents))
+
+			if fi, err := os.Stat(name); err != nil {
+				t.Errorf("Could not stat file %v", err)
+			} else if touched := !fi.ModTime().Equal(old); touched != test.touched {
+				t.Errorf("File written %v expected %v", touched, test.touched)
+			}
+		})
+	}
+}
+
+func TestBlob(t *testing.T) {
+	var w mocWriter
+
+	b := newBlob(&w)
+
+	for _, test := range []struct {
+		name   string
+		data   string
+		offset int
+		size   int
+	}{
+		{"First", "contents", 0, 8},
+		{"Second", "other", 8, 5},
+		{"Copy", "contents", 0, 8},
+		{"Empty", "", 13, 0},
+		{"Other Copy", "other", 8, 5},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			offset, size, err := b.write([]byte(test.data))
+
+			if err != nil {
+				t.Fatalf("write returned unexpected error %v", err)
+			}
+
+			if offset != test.offset || size != test.size {
+				t.Errorf("Did not get expected for %s got (%d:%d) expected (%d:%d)", test.data, offset, size, test.offset, test.size)
+			}
+		})
+	}
+
+	if string(w.bytes) != "contentsother" || b.copies != 2 || b.saved != 13 {
+		t.Errorf("Did not get expected data (%s) copies (%d) saved (%d)", w.bytes, b.copies, b.saved)
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	var (
+		gen     generate
+		reports []string
+	)
+
+	base, err := ioutil.TempDir("", "dedup-test")
+
+	if len(base) > 0 {
+		defer os.RemoveAll(base)
+	}
+
+	if err != nil {
+		t.Fatalf("unable to cerate fs %v", err)
+	}
+
+	script := []byte(strings.Repeat("var a = 1;", 20))
+
+	config := New()
+	config.Output = filepath.Join(base, "assets", "files")
+	config.Logf = func(format string, v ...interface{}) {
+		reports = append(reports, fmt.Sprintf(format, v...))
+	}
+	config.Sources = []Source{{FS: fstest.MapFS{
+		"vendor/lib.js":   &fstest.MapFile{Data: script},
+		"en/lib.js":       &fstest.MapFile{Data: script},
+		"fr/lib.js":       &fstest.MapFile{Data: script},
+		"other/index.txt": &fstest.MapFile{Data: []byte("index")},
+	}}}
+
+	if err = gen.generate(config); err != nil {
+		t.Fatalf("Generate returned unexpected error %v", err)
+	}
+
+	slices := make(map[string]string)
+	for _, f := range gen.Files {
+		slices[f.name] = f.Slice()
+	}
+
+	if slices["/en/lib.js"] != slices["/fr/lib.js"] || slices["/en/lib.js"] != slices["/vendor/lib.js"] {
+		t.Errorf("Identical contents not shared got (%v)", slices)
+	}
+
+	if slices["/en/lib.js"] == slices["/other/index.txt"] {
+		t.Errorf("Different contents shared got (%v)", slices)
+	}
+
+	var size int
+	for _, f := range gen.Files {
+		if f.name == "/en/lib.js" {
+			size = f.dataSize
+		}
+	}
+
+	expect := []string{fmt.Sprintf("deduplicated 2 copies of identical contents, saved %d bytes", 2*size)}
+	if !reflect.DeepEqual(reports, expect) {
+		t.Errorf("Did not get expected report got (%v) expected (%v)", reports, expect)
+	}
+
+	// Without Logf the report is left out
+	var out bytes.Buffer
+
+	log.SetOutput(&out)
+	defer log.SetOutput(os.Stderr)
+
+	config.Logf = nil
+	if err = (&generate{}).generate(config); err != nil {
+		t.Fatalf("Generate returned unexpected error %v", err)
+	}
+
+	if out.Len() > 0 {
+		t.Errorf("Did not expect output without Logf got (%s)", out.String())
+	}
+}